@@ -27,3 +27,10 @@ data "git_commit" "head_parent" {
   directory = "/path/to/git/repository"
   revision  = "HEAD~1"
 }
+
+# verify the signature of a commit
+data "git_commit" "verified" {
+  directory = "/path/to/git/repository"
+  revision  = "HEAD"
+  keyring   = file("/path/to/public/keys.asc")
+}
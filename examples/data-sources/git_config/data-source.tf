@@ -2,3 +2,9 @@ data "git_config" "config" {
   directory = "/path/to/git/repository"
   scope     = "local"
 }
+
+# look up a single key
+data "git_config" "signing" {
+  directory = "/path/to/git/repository"
+  key       = "commit.gpgsign"
+}
@@ -0,0 +1,18 @@
+# unstaged changes in the worktree
+data "git_diff" "worktree" {
+  directory = "/path/to/git/repository"
+}
+
+# staged changes in the index
+data "git_diff" "staged" {
+  directory = "/path/to/git/repository"
+  cached    = true
+}
+
+# changes between two revisions limited to certain paths
+data "git_diff" "release" {
+  directory = "/path/to/git/repository"
+  from      = "v1.0.0"
+  to        = "main"
+  paths     = ["src/**", "README.md"]
+}
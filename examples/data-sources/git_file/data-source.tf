@@ -0,0 +1,16 @@
+data "git_file" "file" {
+  directory = "/path/to/git/repository"
+  revision  = "main"
+  path      = "README.md"
+}
+
+# read a file from the last release tag
+data "git_file" "versions" {
+  directory = "/path/to/git/repository"
+  revision  = "v1.2.3"
+  path      = "versions.json"
+}
+
+locals {
+  versions = jsondecode(data.git_file.versions.content)
+}
@@ -0,0 +1,24 @@
+data "git_ls_remote" "remote" {
+  url = "https://github.com/orga/owner.git"
+}
+
+# list release tags only
+data "git_ls_remote" "tags" {
+  url     = "https://github.com/orga/owner.git"
+  pattern = "refs/tags/v*"
+}
+
+# list branches of a private repository
+data "git_ls_remote" "private" {
+  url     = "git@github.com:orga/owner.git"
+  pattern = "refs/heads/**"
+
+  auth = {
+    ssh_agent = {}
+  }
+}
+
+# use the commit of the default branch of the remote
+output "latest" {
+  value = data.git_ls_remote.remote.refs[data.git_ls_remote.remote.head]
+}
@@ -0,0 +1,3 @@
+data "git_submodules" "submodules" {
+  directory = "/path/to/git/repository"
+}
@@ -2,3 +2,10 @@ data "git_tag" "tag" {
   directory = "/path/to/git/repository"
   name      = "v1.2.3"
 }
+
+# verify the signature of a tag
+data "git_tag" "verified" {
+  directory = "/path/to/git/repository"
+  name      = "v1.2.3"
+  keyring   = file("/path/to/public/keys.asc")
+}
@@ -13,3 +13,9 @@ data "git_tags" "lightweight_tags" {
   annotated   = false
   lightweight = true
 }
+
+# verify the signatures of all annotated tags
+data "git_tags" "verified" {
+  directory = "/path/to/git/repository"
+  keyring   = file("/path/to/public/keys.asc")
+}
@@ -0,0 +1,20 @@
+data "git_tree" "root" {
+  directory = "/path/to/git/repository"
+  revision  = "main"
+}
+
+# list all services of a monorepo
+data "git_tree" "services" {
+  directory = "/path/to/git/repository"
+  revision  = "main"
+  path      = "services"
+}
+
+# find all Terraform files in nested directories
+data "git_tree" "terraform" {
+  directory = "/path/to/git/repository"
+  revision  = "main"
+  recursive = true
+  include   = ["**/*.tf"]
+  exclude   = ["examples/**"]
+}
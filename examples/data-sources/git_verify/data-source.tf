@@ -0,0 +1,23 @@
+data "git_verify" "head" {
+  directory   = "/path/to/git/repository"
+  revision    = "HEAD"
+  public_keys = [file("/path/to/public/key.asc")]
+}
+
+data "git_verify" "tag" {
+  directory            = "/path/to/git/repository"
+  revision             = "v1.2.3"
+  allowed_signers_file = "/path/to/allowed_signers"
+}
+
+# fail in case HEAD is not signed by a trusted key
+data "git_verify" "required" {
+  directory          = "/path/to/git/repository"
+  revision           = "HEAD"
+  fail_on_unverified = true
+  public_keys = [
+    file("/path/to/first/key.asc"),
+    file("/path/to/second/key.asc"),
+  ]
+  allowed_signers_file = "/path/to/allowed_signers"
+}
@@ -0,0 +1,3 @@
+data "git_worktrees" "worktrees" {
+  directory = "/path/to/git/repository"
+}
@@ -1,3 +1,14 @@
 provider "git" {
-  # requires no configuration
+  # all attributes are optional and act as defaults for resources which leave them unset
+  auth = {
+    ssh_key = {
+      private_key_path = pathexpand("~/.ssh/deploy_key")
+    }
+  }
+  ca_bundle_file_path = "/etc/ssl/certs/internal-ca.pem"
+
+  author = {
+    name  = "Terraform"
+    email = "terraform@example.com"
+  }
 }
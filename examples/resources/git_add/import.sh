@@ -0,0 +1,5 @@
+# git_add resources can be imported by specifying the directory of the
+# Git repository and one or more paths to add. All values are separated
+# by a single '|'. Matching files with unstaged changes are added during
+# the next apply.
+terraform import git_add.add 'path/to/your/git/repository|path/to/add|another/path/**'
@@ -0,0 +1,5 @@
+# git_branch resources can be imported by specifying the directory of the
+# Git repository, the name of the branch to import, and the revision. All
+# values are separated by a single '|'. The revision is optional and
+# will default to 'HEAD' if not specified.
+terraform import git_branch.branch 'path/to/your/git/repository|name-of-your-branch|revision'
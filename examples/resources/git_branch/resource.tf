@@ -0,0 +1,29 @@
+# create a branch at HEAD
+resource "git_branch" "branch" {
+  directory = "/path/to/git/repository"
+  name      = "feature"
+}
+
+# create a branch at a specific revision
+resource "git_branch" "revision" {
+  directory = "/path/to/git/repository"
+  name      = "release/v1"
+  revision  = "v1.0.0"
+}
+
+# track a remote branch
+resource "git_branch" "tracking" {
+  directory = "/path/to/git/repository"
+  name      = "main"
+  remote    = "origin"
+  merge     = "main"
+  rebase    = "true"
+}
+
+# reset an existing branch
+resource "git_branch" "force" {
+  directory = "/path/to/git/repository"
+  name      = "deploy"
+  revision  = "refs/remotes/origin/main"
+  force     = true
+}
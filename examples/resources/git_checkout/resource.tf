@@ -0,0 +1,29 @@
+# check out an existing branch
+resource "git_checkout" "branch" {
+  directory = "/path/to/git/repository"
+  branch    = "main"
+}
+
+# create and check out a new branch
+resource "git_checkout" "create" {
+  directory = "/path/to/git/repository"
+  branch    = "feature"
+  create    = true
+}
+
+# pin a clone to a release tag
+resource "git_clone" "clone" {
+  directory = "/path/to/git/repository"
+  url       = "https://github.com/metio/terraform-provider-git.git"
+}
+resource "git_checkout" "tag" {
+  directory = git_clone.clone.directory
+  hash      = "v2023.1.6"
+}
+
+# throw away local changes
+resource "git_checkout" "force" {
+  directory = "/path/to/git/repository"
+  branch    = "main"
+  force     = true
+}
@@ -0,0 +1,21 @@
+# apply a single commit onto the currently checked out branch
+resource "git_cherry_pick" "hotfix" {
+  directory = "/path/to/git/repository"
+  revisions = ["0a1b2c3d4e5f60718293a4b5c6d7e8f901234567"]
+}
+
+# apply multiple commits in order
+resource "git_cherry_pick" "backport" {
+  directory = "/path/to/git/repository"
+  revisions = ["feature~2", "feature"]
+}
+
+# override the author of the created commits
+resource "git_cherry_pick" "author" {
+  directory = "/path/to/git/repository"
+  revisions = ["feature"]
+  author = {
+    name  = "terraform"
+    email = "automation@example.com"
+  }
+}
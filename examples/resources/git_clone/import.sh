@@ -0,0 +1,6 @@
+# git_clone resources can be imported by specifying the path to the Git
+# repository and the name of the remote it was cloned from. Both values
+# are separated by a single '|'. The remote is optional and will default
+# to 'origin' if not specified. The URL, the checked out branch, and the
+# SHA1 hash of 'HEAD' are read from the repository.
+terraform import git_clone.clone 'path/to/your/git/repository|name-of-your-remote'
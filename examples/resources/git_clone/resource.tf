@@ -10,3 +10,20 @@ resource "git_clone" "bare" {
   reference_name = "some-branch"
   bare           = true
 }
+
+resource "git_clone" "shallow" {
+  directory      = "/path/to/git/repository"
+  url            = "https://github.com/orga/owner.git"
+  reference_name = "some-branch"
+  depth          = 1
+  single_branch  = true
+  tags           = "none"
+}
+
+resource "git_clone" "submodules" {
+  directory          = "/path/to/git/repository"
+  url                = "https://github.com/orga/owner.git"
+  reference_name     = "some-branch"
+  recurse_submodules = true
+  shallow_submodules = true
+}
@@ -0,0 +1,5 @@
+# git_commit resources can be imported by specifying the directory of the
+# Git repository and the SHA1 hash of the commit to import. Both values are
+# separated by a single '|'. The message, author, committer, and files are
+# read from the commit.
+terraform import git_commit.commit 'path/to/your/git/repository|sha1-of-your-commit'
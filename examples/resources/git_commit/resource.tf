@@ -37,3 +37,23 @@ resource "git_commit" "commit_on_change" {
     replace_triggered_by = [git_add.add.id]
   }
 }
+
+# sign commit with an OpenPGP key
+resource "git_commit" "openpgp" {
+  directory = "/path/to/git/repository"
+  message   = "committed with terraform"
+  signing = {
+    private_key_path = "/path/to/private/key.asc"
+    passphrase       = var.passphrase
+  }
+}
+
+# sign commit with an SSH key
+resource "git_commit" "ssh" {
+  directory = "/path/to/git/repository"
+  message   = "committed with terraform"
+  signing = {
+    format          = "ssh"
+    private_key_pem = file("/path/to/.ssh/id_ed25519")
+  }
+}
@@ -0,0 +1,4 @@
+# git_config resources can be imported by specifying the directory of the
+# Git repository, the scope, and the key to import. All values are
+# separated by a single '|'.
+terraform import git_config.config 'path/to/your/git/repository|local|core.hooksPath'
@@ -0,0 +1,21 @@
+# use the hooks stored in the repository
+resource "git_config" "hooks" {
+  directory = "/path/to/git/repository"
+  key       = "core.hooksPath"
+  value     = ".githooks"
+}
+
+# rewrite multiple URL prefixes
+resource "git_config" "github" {
+  directory = "/path/to/git/repository"
+  key       = "url.git@github.com:.insteadOf"
+  values    = ["https://github.com/", "gh:"]
+}
+
+# write to the global configuration of the current user
+resource "git_config" "rebase" {
+  directory = "/path/to/git/repository"
+  scope     = "global"
+  key       = "pull.rebase"
+  value     = "true"
+}
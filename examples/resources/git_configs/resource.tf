@@ -0,0 +1,8 @@
+resource "git_configs" "settings" {
+  directory = "/path/to/git/repository"
+  entries = {
+    "core.hooksPath"                = [".githooks"]
+    "pull.rebase"                   = ["true"]
+    "url.git@github.com:.insteadOf" = ["https://github.com/", "gh:"]
+  }
+}
@@ -0,0 +1,49 @@
+# fetch from the default remote
+resource "git_fetch" "fetch" {
+  directory = "/path/to/git/repository"
+}
+
+# specify remote
+resource "git_fetch" "remote" {
+  directory = "/path/to/git/repository"
+  remote    = "upstream"
+}
+
+# fetch a single branch into a custom ref
+resource "git_fetch" "refspecs" {
+  directory = "/path/to/git/repository"
+  refspecs  = ["+refs/heads/main:refs/remotes/origin/main"]
+}
+
+# shallow fetch without tags
+resource "git_fetch" "shallow" {
+  directory = "/path/to/git/repository"
+  depth     = 1
+  tags      = "none"
+}
+
+# remove stale remote-tracking refs
+resource "git_fetch" "prune" {
+  directory = "/path/to/git/repository"
+  prune     = true
+}
+
+# fetch with SSH key
+resource "git_fetch" "ssh" {
+  directory = "/path/to/git/repository"
+
+  auth = {
+    ssh_key = {
+      private_key_path = pathexpand("~/.ssh/id_rsa")
+    }
+  }
+}
+
+# refresh a clone managed by Terraform
+resource "git_clone" "clone" {
+  directory = "/path/to/git/repository"
+  url       = "https://github.com/metio/terraform-provider-git.git"
+}
+resource "git_fetch" "refresh" {
+  directory = git_clone.clone.directory
+}
@@ -0,0 +1,4 @@
+# git_file resources can be imported by specifying the directory of the
+# Git repository and the path of the file relative to the root of the
+# worktree. Both values are separated by a single '|'.
+terraform import git_file.file 'path/to/your/git/repository|path/to/file'
@@ -0,0 +1,21 @@
+# write and stage a text file
+resource "git_file" "readme" {
+  directory = "/path/to/git/repository"
+  path      = "README.md"
+  content   = "# Example"
+}
+
+# write and stage an executable script in a nested directory
+resource "git_file" "script" {
+  directory = "/path/to/git/repository"
+  path      = "scripts/build.sh"
+  content   = file("${path.module}/build.sh")
+  mode      = "0755"
+}
+
+# write and stage a binary file
+resource "git_file" "binary" {
+  directory      = "/path/to/git/repository"
+  path           = "assets/logo.png"
+  content_base64 = filebase64("${path.module}/logo.png")
+}
@@ -0,0 +1,34 @@
+# merge a branch into the currently checked out branch
+resource "git_merge" "merge" {
+  directory = "/path/to/git/repository"
+  revision  = "release/1.0"
+}
+
+# always create a merge commit
+resource "git_merge" "no_ff" {
+  directory = "/path/to/git/repository"
+  revision  = "release/1.0"
+  strategy  = "no-ff"
+  message   = "merged with terraform"
+}
+
+# fail unless the branch can be fast-forwarded
+resource "git_merge" "fast_forward" {
+  directory = "/path/to/git/repository"
+  revision  = "origin/main"
+  strategy  = "fast-forward-only"
+}
+
+# specify author and committer of the merge commit
+resource "git_merge" "author" {
+  directory = "/path/to/git/repository"
+  revision  = "release/1.0"
+  author = {
+    name  = "terraform"
+    email = "automation@example.com"
+  }
+  committer = {
+    name  = "terraform"
+    email = "automation@example.com"
+  }
+}
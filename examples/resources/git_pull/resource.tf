@@ -0,0 +1,31 @@
+# pull changes from the default remote
+resource "git_pull" "pull" {
+  directory = "/path/to/git/repository"
+}
+
+# pull a specific branch from a specific remote
+resource "git_pull" "branch" {
+  directory      = "/path/to/git/repository"
+  remote_name    = "upstream"
+  reference_name = "main"
+}
+
+# pull with SSH key
+resource "git_pull" "ssh" {
+  directory = "/path/to/git/repository"
+
+  auth = {
+    ssh_key = {
+      private_key_path = pathexpand("~/.ssh/id_rsa")
+    }
+  }
+}
+
+# keep a clone managed by Terraform up to date
+resource "git_clone" "clone" {
+  directory = "/path/to/git/repository"
+  url       = "https://github.com/metio/terraform-provider-git.git"
+}
+resource "git_pull" "update" {
+  directory = git_clone.clone.directory
+}
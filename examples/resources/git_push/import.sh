@@ -0,0 +1,5 @@
+# git_push resources can be imported by specifying the directory of the
+# Git repository, the name of the remote to push into, and one or more
+# refspecs. All values are separated by a single '|'. Local refs which
+# are ahead of the remote are pushed during the next apply.
+terraform import git_push.push 'path/to/your/git/repository|name-of-your-remote|refs/heads/main:refs/heads/main'
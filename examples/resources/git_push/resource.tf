@@ -18,6 +18,35 @@ resource "git_push" "remote" {
   force     = true
 }
 
+# force push unless someone else pushed in the meantime
+resource "git_push" "remote" {
+  directory = "/path/to/git/repository"
+  refspecs  = ["refs/heads/master:refs/heads/master"]
+
+  force_with_lease = {
+    ref_name = "refs/heads/master"
+    sha1     = "b1af8d13f5131c9b4de9ddd06e311c2e79fdb285"
+  }
+}
+
+# push annotated tags along with the branch in a single transaction
+resource "git_push" "remote" {
+  directory   = "/path/to/git/repository"
+  refspecs    = ["refs/heads/master:refs/heads/master"]
+  follow_tags = true
+  atomic      = true
+}
+
+# send push options to the server
+resource "git_push" "remote" {
+  directory = "/path/to/git/repository"
+  refspecs  = ["refs/heads/master:refs/heads/master"]
+
+  options = {
+    "ci.skip" = ""
+  }
+}
+
 # push with basic auth
 resource "git_push" "remote" {
   directory = "/path/to/git/repository"
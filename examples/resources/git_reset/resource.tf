@@ -0,0 +1,26 @@
+# unstage all changes
+resource "git_reset" "unstage" {
+  directory = "/path/to/git/repository"
+}
+
+# move the current branch back without touching the index or the worktree
+resource "git_reset" "soft" {
+  directory = "/path/to/git/repository"
+  revision  = "0a1b2c3d4e5f60718293a4b5c6d7e8f901234567"
+  mode      = "soft"
+}
+
+# discard all local changes to tracked files
+resource "git_reset" "clean" {
+  directory = "/path/to/git/repository"
+  mode      = "hard"
+  force     = true
+}
+
+# restore specific files from another revision
+resource "git_reset" "files" {
+  directory = "/path/to/git/repository"
+  revision  = "origin/main"
+  mode      = "hard"
+  files     = ["some/generated/file", "some/directory"]
+}
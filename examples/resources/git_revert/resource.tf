@@ -0,0 +1,21 @@
+# undo the last commit
+resource "git_revert" "last" {
+  directory = "/path/to/git/repository"
+  revisions = ["HEAD"]
+}
+
+# undo multiple commits, newest first
+resource "git_revert" "multiple" {
+  directory = "/path/to/git/repository"
+  revisions = ["HEAD", "HEAD~1"]
+}
+
+# specify author of the created commits
+resource "git_revert" "author" {
+  directory = "/path/to/git/repository"
+  revisions = ["0a1b2c3d4e5f60718293a4b5c6d7e8f901234567"]
+  author = {
+    name  = "terraform"
+    email = "automation@example.com"
+  }
+}
@@ -0,0 +1,11 @@
+# initialize and update all submodules
+resource "git_submodule" "all" {
+  directory = "/path/to/git/repository"
+}
+
+# update a single submodule with a shallow history
+resource "git_submodule" "library" {
+  directory = "/path/to/git/repository"
+  name      = "library"
+  depth     = 1
+}
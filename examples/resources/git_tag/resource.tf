@@ -26,3 +26,35 @@ resource "git_tag" "branch" {
   name      = "v1.2.3"
   revision  = "main"
 }
+
+resource "git_tag" "tagger" {
+  directory = "/path/to/git/repository"
+  name      = "v1.2.3"
+  message   = "some message for the new tag"
+  tagger = {
+    name  = "Release Bot"
+    email = "release@example.com"
+  }
+}
+
+# sign tag with an OpenPGP key
+resource "git_tag" "signed" {
+  directory = "/path/to/git/repository"
+  name      = "v1.2.3"
+  message   = "some message for the new tag"
+  signing = {
+    private_key_path = "/path/to/private/key.asc"
+    passphrase       = var.passphrase
+  }
+}
+
+# sign tag with an SSH key
+resource "git_tag" "ssh" {
+  directory = "/path/to/git/repository"
+  name      = "v1.2.3"
+  message   = "some message for the new tag"
+  signing = {
+    format          = "ssh"
+    private_key_pem = file("/path/to/.ssh/id_ed25519")
+  }
+}
@@ -0,0 +1,24 @@
+# check out an existing branch in a separate directory
+resource "git_worktree" "release" {
+  directory = "/path/to/git/repository"
+  path      = "/path/to/release/worktree"
+  branch    = "release"
+}
+
+# create a new branch and keep the worktree from being pruned
+resource "git_worktree" "feature" {
+  directory   = "/path/to/git/repository"
+  path        = "/path/to/feature/worktree"
+  branch      = "feature"
+  revision    = "origin/main"
+  create      = true
+  locked      = true
+  lock_reason = "managed by terraform"
+}
+
+# check out a tag in detached mode
+resource "git_worktree" "tag" {
+  directory = "/path/to/git/repository"
+  path      = "/path/to/tag/worktree"
+  revision  = "v1.2.3"
+}
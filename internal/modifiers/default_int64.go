@@ -0,0 +1,40 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package modifiers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DefaultInt64 accepts an int64 and uses the supplied value to set a default if the config for
+// the attribute is null.
+func DefaultInt64(val int64) planmodifier.Int64 {
+	return &defaultInt64PlanModifier{types.Int64Value(val)}
+}
+
+type defaultInt64PlanModifier struct {
+	defaultValue types.Int64
+}
+
+func (d *defaultInt64PlanModifier) Description(_ context.Context) string {
+	return "If the config does not contain a value, a default will be set using defaultValue."
+}
+
+func (d *defaultInt64PlanModifier) MarkdownDescription(ctx context.Context) string {
+	return d.Description(ctx)
+}
+
+// PlanModifyInt64 checks that the value of the attribute in the configuration and assigns the default value if
+// the value in the config is null. This is a destructive operation in that it will overwrite any value
+// present in the plan.
+func (d *defaultInt64PlanModifier) PlanModifyInt64(_ context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if req.ConfigValue.IsNull() {
+		resp.PlanValue = d.defaultValue
+	}
+}
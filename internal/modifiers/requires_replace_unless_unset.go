@@ -0,0 +1,40 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package modifiers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+// RequiresReplaceBoolUnlessUnset requires a replacement of the resource when the value of the attribute changes,
+// except when the configuration drops the attribute while the state still contains the given former default value.
+// Both cases behave the same, thus existing resources do not have to be replaced after a default was removed.
+func RequiresReplaceBoolUnlessUnset(former bool) planmodifier.Bool {
+	return boolplanmodifier.RequiresReplaceIf(
+		func(_ context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !(req.PlanValue.IsNull() && req.StateValue.ValueBool() == former)
+		},
+		"If the value of this attribute changes, Terraform will destroy and recreate the resource unless the value is unset.",
+		"If the value of this attribute changes, Terraform will destroy and recreate the resource unless the value is unset.",
+	)
+}
+
+// RequiresReplaceStringUnlessUnset requires a replacement of the resource when the value of the attribute changes,
+// except when the configuration drops the attribute while the state still contains the given former default value.
+// Both cases behave the same, thus existing resources do not have to be replaced after a default was removed.
+func RequiresReplaceStringUnlessUnset(former string) planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !(req.PlanValue.IsNull() && req.StateValue.ValueString() == former)
+		},
+		"If the value of this attribute changes, Terraform will destroy and recreate the resource unless the value is unset.",
+		"If the value of this attribute changes, Terraform will destroy and recreate the resource unless the value is unset.",
+	)
+}
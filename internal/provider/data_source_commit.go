@@ -31,6 +31,8 @@ type commitDataSourceModel struct {
 	Committer types.Object `tfsdk:"committer"`
 	Message   types.String `tfsdk:"message"`
 	Signature types.String `tfsdk:"signature"`
+	Keyring   types.String `tfsdk:"keyring"`
+	Verified  types.Bool   `tfsdk:"verified"`
 	TreeSHA1  types.String `tfsdk:"tree_sha1"`
 	Files     types.List   `tfsdk:"files"`
 }
@@ -128,6 +130,19 @@ func (d *CommitDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				MarkdownDescription: "The signature of the commit.",
 				Computed:            true,
 			},
+			"keyring": schema.StringAttribute{
+				Description:         "The armored OpenPGP public keys to verify the signature of the commit with.",
+				MarkdownDescription: "The armored OpenPGP public keys to verify the signature of the commit with.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"verified": schema.BoolAttribute{
+				Description:         "Whether the commit carries a valid signature of one of the keys in 'keyring'. Only set in case 'keyring' is specified.",
+				MarkdownDescription: "Whether the commit carries a valid signature of one of the keys in `keyring`. Only set in case `keyring` is specified.",
+				Computed:            true,
+			},
 			"tree_sha1": schema.StringAttribute{
 				Description:         "The SHA1 checksum of the root tree of the commit.",
 				MarkdownDescription: "The SHA1 checksum of the root tree of the commit.",
@@ -179,6 +194,28 @@ func (d *CommitDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	state.SHA1 = types.StringValue(commitObject.Hash.String())
 	state.Message = types.StringValue(commitObject.Message)
 	state.Signature = types.StringValue(commitObject.PGPSignature)
+	state.Keyring = inputs.Keyring
+	state.Verified = types.BoolNull()
+	if !inputs.Keyring.IsNull() {
+		keyring := readKeyring(inputs.Keyring.ValueString(), &resp.Diagnostics)
+		if keyring == nil {
+			return
+		}
+		entity, err := verifyOpenPGPSignature(commitObject, commitObject.PGPSignature, keyring)
+		if err != nil {
+			tflog.Trace(ctx, "cannot verify commit", map[string]interface{}{
+				"commit": commitObject.Hash.String(),
+				"error":  err.Error(),
+			})
+			state.Verified = types.BoolValue(false)
+		} else {
+			tflog.Trace(ctx, "verified commit", map[string]interface{}{
+				"commit": commitObject.Hash.String(),
+				"key_id": entity.PrimaryKey.KeyIdString(),
+			})
+			state.Verified = types.BoolValue(true)
+		}
+	}
 	state.TreeSHA1 = types.StringValue(commitObject.TreeHash.String())
 	state.Author = signatureToObject(&commitObject.Author)
 	state.Committer = signatureToObject(&commitObject.Committer)
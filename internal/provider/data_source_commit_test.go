@@ -7,6 +7,7 @@ package provider_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/go-git/go-git/v5"
@@ -312,3 +313,97 @@ func TestDataSourceGitCommit_WithSignature(t *testing.T) {
 		},
 	})
 }
+
+func TestDataSourceGitCommit_Verified(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	fileName := "some-file"
+	testutils.WriteFileInWorktree(t, worktree, fileName)
+	testutils.GitAdd(t, worktree, fileName)
+	entity, _, publicKey := testutils.OpenPGPKey(t, "")
+	_, _, otherPublicKey := testutils.OpenPGPKey(t, "")
+	commit := testutils.GitCommitWith(t, worktree, &git.CommitOptions{
+		Author:  testutils.Signature(),
+		SignKey: entity,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_commit" "signer" {
+						directory = "%s"
+						revision  = "%s"
+						keyring   = <<EOT
+%sEOT
+					}
+
+					data "git_commit" "other" {
+						directory = "%s"
+						revision  = "%s"
+						keyring   = <<EOT
+%sEOT
+					}
+				`, directory, commit.String(), publicKey, directory, commit.String(), otherPublicKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("data.git_commit.signer", "signature", testutils.CheckMinLength(1)),
+					resource.TestCheckResourceAttr("data.git_commit.signer", "verified", "true"),
+					resource.TestCheckResourceAttr("data.git_commit.other", "verified", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitCommit_Verified_Unsigned(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	_, _, publicKey := testutils.OpenPGPKey(t, "")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_commit" "test" {
+						directory = "%s"
+						revision  = "HEAD"
+						keyring   = <<EOT
+%sEOT
+					}
+				`, directory, publicKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_commit.test", "signature", ""),
+					resource.TestCheckResourceAttr("data.git_commit.test", "verified", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitCommit_InvalidKeyring(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_commit" "test" {
+						directory = "%s"
+						revision  = "HEAD"
+						keyring   = "not a keyring"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Invalid keyring`),
+			},
+		},
+	})
+}
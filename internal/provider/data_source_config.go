@@ -32,6 +32,10 @@ type configDataSourceModel struct {
 	AuthorEmail    types.String `tfsdk:"author_email"`
 	CommitterName  types.String `tfsdk:"committer_name"`
 	CommitterEmail types.String `tfsdk:"committer_email"`
+	Key            types.String `tfsdk:"key"`
+	Value          types.String `tfsdk:"value"`
+	Values         types.List   `tfsdk:"values"`
+	Raw            types.Map    `tfsdk:"raw"`
 }
 
 func NewConfigDataSource() datasource.DataSource {
@@ -44,8 +48,8 @@ func (d *ConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRe
 
 func (d *ConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description:         "Reads the configuration of a Git repository.",
-		MarkdownDescription: "Reads the configuration of a Git repository.",
+		Description:         "Reads the configuration of a Git repository. Files referenced by 'include' and 'includeIf' directives are read as well, similar to 'git config --list'. Only the 'gitdir', 'gitdir/i', and 'onbranch' conditions of 'includeIf' are supported.",
+		MarkdownDescription: "Reads the configuration of a Git repository. Files referenced by [`include` and `includeIf`](https://git-scm.com/docs/git-config#_includes) directives are read as well, similar to `git config --list`. Only the `gitdir`, `gitdir/i`, and `onbranch` conditions of `includeIf` are supported.",
 		Attributes: map[string]schema.Attribute{
 			"directory": schema.StringAttribute{
 				Description:         "The path to the local Git repository.",
@@ -103,6 +107,31 @@ func (d *ConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				MarkdownDescription: "The email address of the committer of a commit.",
 				Computed:            true,
 			},
+			"key": schema.StringAttribute{
+				Description:         "The configuration key to look up in the form 'section.name' or 'section.subsection.name', e.g. 'init.defaultBranch'. Section and name are case-insensitive while the subsection is case-sensitive.",
+				MarkdownDescription: "The configuration key to look up in the form `section.name` or `section.subsection.name`, e.g. `init.defaultBranch`. Section and name are case-insensitive while the subsection is case-sensitive.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(configKeyPattern, "must be in the form 'section.name' or 'section.subsection.name'"),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description:         "The effective value of 'key' which is the last value git reads. Not set in case 'key' is not specified or not configured.",
+				MarkdownDescription: "The effective value of `key` which is the last value git reads. Not set in case `key` is not specified or not configured.",
+				Computed:            true,
+			},
+			"values": schema.ListAttribute{
+				Description:         "All values of 'key' in the order git reads them. Empty in case 'key' is not configured.",
+				MarkdownDescription: "All values of `key` in the order git reads them. Empty in case `key` is not configured.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"raw": schema.MapAttribute{
+				Description:         "All configuration keys of the chosen scope mapped to their values in the order git reads them. Keys use the form of 'git config --list' which has a lowercase section and name, e.g. 'core.hookspath' or 'url.git@github.com:.insteadof'.",
+				MarkdownDescription: "All configuration keys of the chosen scope mapped to their values in the order git reads them. Keys use the form of `git config --list` which has a lowercase section and name, e.g. `core.hookspath` or `url.git@github.com:.insteadof`.",
+				ElementType:         types.ListType{ElemType: types.StringType},
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -147,6 +176,38 @@ func (d *ConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	state.CommitterName = types.StringValue(cfg.Committer.Name)
 	state.CommitterEmail = types.StringValue(cfg.Committer.Email)
 
+	entries := readConfigEntries(ctx, repository, scope, &resp.Diagnostics)
+	if entries == nil {
+		return
+	}
+
+	raw := make(map[string][]string)
+	for _, entry := range entries {
+		raw[entry.key] = append(raw[entry.key], entry.value)
+	}
+	state.Raw, diags = types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, raw)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Key = inputs.Key
+	state.Value = types.StringNull()
+	state.Values = types.ListNull(types.StringType)
+	if !inputs.Key.IsNull() {
+		values := raw[canonicalConfigKey(inputs.Key.ValueString())]
+		if len(values) > 0 {
+			state.Value = types.StringValue(values[len(values)-1])
+		} else {
+			values = []string{}
+		}
+		state.Values, diags = types.ListValueFrom(ctx, types.StringType, values)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
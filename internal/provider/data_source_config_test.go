@@ -7,6 +7,7 @@ package provider_test
 
 import (
 	"fmt"
+	"path/filepath"
 	"regexp"
 	"testing"
 
@@ -188,3 +189,146 @@ func TestDataSourceGitConfig_MissingRepository(t *testing.T) {
 		},
 	})
 }
+
+func TestDataSourceGitConfig_Key(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	cfg := testutils.TestConfig(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_config" "test" {
+						directory = "%s"
+						scope     = "local"
+						key       = "User.Name"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_config.test", "key", "User.Name"),
+					resource.TestCheckResourceAttr("data.git_config.test", "value", cfg.User.Name),
+					resource.TestCheckResourceAttr("data.git_config.test", "values.#", "1"),
+					resource.TestCheckResourceAttr("data.git_config.test", "values.0", cfg.User.Name),
+					resource.TestCheckResourceAttr("data.git_config.test", "raw.user.name.#", "1"),
+					resource.TestCheckResourceAttr("data.git_config.test", "raw.user.name.0", cfg.User.Name),
+					resource.TestCheckResourceAttr("data.git_config.test", "raw.core.bare.0", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitConfig_Key_MultipleValues(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+	testutils.AppendConfig(t, directory, "[url \"git@github.com:\"]\n\tinsteadOf = https://github.com/\n\tinsteadOf = gh:\n")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_config" "test" {
+						directory = "%s"
+						scope     = "local"
+						key       = "url.git@github.com:.insteadOf"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_config.test", "value", "gh:"),
+					resource.TestCheckResourceAttr("data.git_config.test", "values.#", "2"),
+					resource.TestCheckResourceAttr("data.git_config.test", "values.0", "https://github.com/"),
+					resource.TestCheckResourceAttr("data.git_config.test", "values.1", "gh:"),
+					resource.TestCheckResourceAttr("data.git_config.test", "raw.url.git@github.com:.insteadof.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitConfig_Key_Missing(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_config" "test" {
+						directory = "%s"
+						scope     = "local"
+						key       = "commit.gpgsign"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("data.git_config.test", "value"),
+					resource.TestCheckResourceAttr("data.git_config.test", "values.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitConfig_Key_Invalid(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_config" "test" {
+						directory = "%s"
+						key       = "gpgsign"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitConfig_Include(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+	includes := testutils.TemporaryDirectory(t)
+	testutils.WriteFileContent(t, filepath.Join(includes, "common.inc"), "[init]\n\tdefaultBranch = main\n")
+	testutils.WriteFileContent(t, filepath.Join(includes, "gitdir.inc"), "[commit]\n\tgpgsign = true\n")
+	testutils.WriteFileContent(t, filepath.Join(includes, "branch.inc"), "[core]\n\tautocrlf = input\n")
+	testutils.WriteFileContent(t, filepath.Join(includes, "other.inc"), "[core]\n\tautocrlf = true\n")
+	testutils.AppendConfig(t, directory, fmt.Sprintf(`[include]
+	path = %[1]s/common.inc
+[includeIf "gitdir:%[2]s/"]
+	path = %[1]s/gitdir.inc
+[includeIf "onbranch:master"]
+	path = %[1]s/branch.inc
+[includeIf "onbranch:other"]
+	path = %[1]s/other.inc
+`, includes, directory))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_config" "test" {
+						directory = "%s"
+						scope     = "local"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_config.test", "raw.init.defaultbranch.0", "main"),
+					resource.TestCheckResourceAttr("data.git_config.test", "raw.commit.gpgsign.0", "true"),
+					resource.TestCheckResourceAttr("data.git_config.test", "raw.core.autocrlf.#", "1"),
+					resource.TestCheckResourceAttr("data.git_config.test", "raw.core.autocrlf.0", "input"),
+					resource.TestCheckNoResourceAttr("data.git_config.test", "value"),
+					resource.TestCheckNoResourceAttr("data.git_config.test", "values"),
+				),
+			},
+		},
+	})
+}
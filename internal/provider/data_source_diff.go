@@ -0,0 +1,324 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type DiffDataSource struct{}
+
+var (
+	_ datasource.DataSource = (*DiffDataSource)(nil)
+)
+
+type diffDataSourceModel struct {
+	Directory types.String `tfsdk:"directory"`
+	Id        types.String `tfsdk:"id"`
+	From      types.String `tfsdk:"from"`
+	To        types.String `tfsdk:"to"`
+	Cached    types.Bool   `tfsdk:"cached"`
+	Paths     types.List   `tfsdk:"paths"`
+	Renames   types.Bool   `tfsdk:"renames"`
+	Files     types.Map    `tfsdk:"files"`
+	Additions types.Int64  `tfsdk:"additions"`
+	Deletions types.Int64  `tfsdk:"deletions"`
+	Patch     types.String `tfsdk:"patch"`
+}
+
+func NewDiffDataSource() datasource.DataSource {
+	return &DiffDataSource{}
+}
+
+func (d *DiffDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_diff"
+}
+
+func (d *DiffDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Fetches the changes between two revisions, the index, or the worktree of a Git repository similar to 'git diff'.",
+		MarkdownDescription: "Fetches the changes between two revisions, the index, or the worktree of a Git repository similar to `git diff`.",
+		Attributes: map[string]schema.Attribute{
+			"directory": schema.StringAttribute{
+				Description:         "The path to the local Git repository.",
+				MarkdownDescription: "The path to the local Git repository.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description:         "The same value as the 'directory' attribute.",
+				MarkdownDescription: "The same value as the `directory` attribute.",
+				Computed:            true,
+			},
+			"from": schema.StringAttribute{
+				Description:         "The revision to compare from. Defaults to the index, or to 'HEAD' in case 'cached' is enabled.",
+				MarkdownDescription: "The [revision](https://www.git-scm.com/docs/gitrevisions) to compare from. Defaults to the index, or to `HEAD` in case `cached` is enabled.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"to": schema.StringAttribute{
+				Description:         "The revision to compare to. Defaults to the worktree, or to the index in case 'cached' is enabled.",
+				MarkdownDescription: "The [revision](https://www.git-scm.com/docs/gitrevisions) to compare to. Defaults to the worktree, or to the index in case `cached` is enabled.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"cached": schema.BoolAttribute{
+				Description:         "Compare the staged changes in the index instead of the worktree similar to 'git diff --cached'. Cannot be used together with 'to'. Defaults to 'false'.",
+				MarkdownDescription: "Compare the staged changes in the index instead of the worktree similar to `git diff --cached`. Cannot be used together with `to`. Defaults to `false`.",
+				Optional:            true,
+			},
+			"paths": schema.ListAttribute{
+				Description:         "Limit the diff to files matching one of the given paths. Values can be exact paths or glob patterns.",
+				MarkdownDescription: "Limit the diff to files matching one of the given paths. Values can be exact paths or glob patterns.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"renames": schema.BoolAttribute{
+				Description:         "Whether to detect renamed files. Defaults to 'true'.",
+				MarkdownDescription: "Whether to detect renamed files. Defaults to `true`.",
+				Optional:            true,
+			},
+			"files": schema.MapNestedAttribute{
+				Description:         "The changed files keyed by their path. Deleted files use their old path.",
+				MarkdownDescription: "The changed files keyed by their path. Deleted files use their old path.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"status": schema.StringAttribute{
+							Description:         "The status of the file. Possible values are 'added', 'modified', 'deleted', and 'renamed'.",
+							MarkdownDescription: "The status of the file. Possible values are `added`, `modified`, `deleted`, and `renamed`.",
+							Computed:            true,
+						},
+						"old_path": schema.StringAttribute{
+							Description:         "The previous path of a renamed file.",
+							MarkdownDescription: "The previous path of a renamed file.",
+							Computed:            true,
+						},
+						"additions": schema.Int64Attribute{
+							Description:         "The number of added lines. Always '0' for binary files.",
+							MarkdownDescription: "The number of added lines. Always `0` for binary files.",
+							Computed:            true,
+						},
+						"deletions": schema.Int64Attribute{
+							Description:         "The number of deleted lines. Always '0' for binary files.",
+							MarkdownDescription: "The number of deleted lines. Always `0` for binary files.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"additions": schema.Int64Attribute{
+				Description:         "The total number of added lines.",
+				MarkdownDescription: "The total number of added lines.",
+				Computed:            true,
+			},
+			"deletions": schema.Int64Attribute{
+				Description:         "The total number of deleted lines.",
+				MarkdownDescription: "The total number of deleted lines.",
+				Computed:            true,
+			},
+			"patch": schema.StringAttribute{
+				Description:         "The changes in the unified diff format.",
+				MarkdownDescription: "The changes in the unified diff format.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DiffDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Read data source git_diff")
+
+	var inputs diffDataSourceModel
+	var state diffDataSourceModel
+
+	diags := req.Config.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if inputs.Cached.IsNull() {
+		inputs.Cached = types.BoolValue(false)
+	}
+	if inputs.Renames.IsNull() {
+		inputs.Renames = types.BoolValue(true)
+	}
+
+	if inputs.Cached.ValueBool() && !inputs.To.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid diff options",
+			"The 'cached' attribute cannot be used together with 'to' because cached diffs always compare to the index.",
+		)
+		return
+	}
+
+	patterns := make([]string, 0)
+	if !inputs.Paths.IsNull() {
+		resp.Diagnostics.Append(inputs.Paths.ElementsAs(ctx, &patterns, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	for _, pattern := range patterns {
+		if !doublestar.ValidatePathPattern(pattern) {
+			resp.Diagnostics.AddError(
+				"Cannot match file path",
+				"The pattern ["+pattern+"] is not a valid glob pattern",
+			)
+			return
+		}
+	}
+
+	directory := inputs.Directory.ValueString()
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	var worktree *git.Worktree
+	if inputs.From.IsNull() || inputs.To.IsNull() {
+		var err error
+		worktree, err = repository.Worktree()
+		if errors.Is(err, git.ErrIsBareRepository) {
+			resp.Diagnostics.AddError(
+				"Cannot diff bare repository",
+				"The repository ["+directory+"] has neither an index nor a worktree, thus both 'from' and 'to' must be specified.",
+			)
+			return
+		} else if err != nil {
+			resp.Diagnostics.AddError(
+				"Cannot read worktree",
+				"Could not read worktree because of: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	storage := newOverlayStorage(repository)
+
+	var from *object.Tree
+	if !inputs.From.IsNull() {
+		from = revisionTree(ctx, repository, inputs.From.ValueString(), &resp.Diagnostics)
+	} else if inputs.Cached.ValueBool() {
+		from = headTree(ctx, repository, storage, &resp.Diagnostics)
+	} else {
+		from = indexTree(ctx, repository, storage, &resp.Diagnostics)
+	}
+	if from == nil {
+		return
+	}
+
+	var to *object.Tree
+	if !inputs.To.IsNull() {
+		to = revisionTree(ctx, repository, inputs.To.ValueString(), &resp.Diagnostics)
+	} else if inputs.Cached.ValueBool() {
+		to = indexTree(ctx, repository, storage, &resp.Diagnostics)
+	} else {
+		to = worktreeTree(ctx, repository, worktree, storage, &resp.Diagnostics)
+	}
+	if to == nil {
+		return
+	}
+
+	changes := diffTrees(ctx, from, to, patterns, inputs.Renames.ValueBool(), &resp.Diagnostics)
+	if changes == nil {
+		return
+	}
+
+	patch, err := changes.PatchContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Cannot create patch",
+			"Could not create patch because of: "+err.Error(),
+		)
+		return
+	}
+
+	fileType := map[string]attr.Type{
+		"status":    types.StringType,
+		"old_path":  types.StringType,
+		"additions": types.Int64Type,
+		"deletions": types.Int64Type,
+	}
+
+	var totalAdditions, totalDeletions int64
+	allFiles := make(map[string]attr.Value)
+	for i, filePatch := range patch.FilePatches() {
+		change := changes[i]
+		status := changeStatus(change)
+		oldPath := types.StringNull()
+		if status == "renamed" {
+			oldPath = types.StringValue(change.From.Name)
+		}
+		additions, deletions := filePatchStats(filePatch)
+		totalAdditions += additions
+		totalDeletions += deletions
+		allFiles[changePath(change)] = types.ObjectValueMust(
+			fileType,
+			map[string]attr.Value{
+				"status":    types.StringValue(status),
+				"old_path":  oldPath,
+				"additions": types.Int64Value(additions),
+				"deletions": types.Int64Value(deletions),
+			},
+		)
+	}
+
+	tflog.Trace(ctx, "read diff", map[string]interface{}{
+		"directory": directory,
+		"files":     len(allFiles),
+		"additions": totalAdditions,
+		"deletions": totalDeletions,
+	})
+
+	state.Directory = inputs.Directory
+	state.Id = inputs.Directory
+	state.From = inputs.From
+	state.To = inputs.To
+	state.Cached = inputs.Cached
+	state.Paths = inputs.Paths
+	state.Renames = inputs.Renames
+	state.Files = types.MapValueMust(
+		types.ObjectType{
+			AttrTypes: fileType,
+		},
+		allFiles,
+	)
+	state.Additions = types.Int64Value(totalAdditions)
+	state.Deletions = types.Int64Value(totalDeletions)
+	state.Patch = types.StringValue(patch.String())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
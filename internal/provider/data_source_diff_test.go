@@ -0,0 +1,368 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/metio/terraform-provider-git/internal/testutils"
+)
+
+func TestDataSourceGitDiff(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	testutils.WriteFileContent(t, testutils.FileInWorktree(worktree, "some-file"), "hello world!\nsome changes\n")
+	if err := os.Remove(testutils.FileInWorktree(worktree, "other-file")); err != nil {
+		t.Fatal(err)
+	}
+	testutils.WriteFileInWorktree(t, worktree, "untracked-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_diff" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_diff.test", "directory", directory),
+					resource.TestCheckResourceAttr("data.git_diff.test", "id", directory),
+					resource.TestCheckNoResourceAttr("data.git_diff.test", "from"),
+					resource.TestCheckNoResourceAttr("data.git_diff.test", "to"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.%", "2"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.some-file.status", "modified"),
+					resource.TestCheckNoResourceAttr("data.git_diff.test", "files.some-file.old_path"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.some-file.additions", "2"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.some-file.deletions", "1"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.other-file.status", "deleted"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.other-file.additions", "0"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.other-file.deletions", "1"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "additions", "2"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "deletions", "2"),
+					resource.TestMatchResourceAttr("data.git_diff.test", "patch", regexp.MustCompile(`(?m)^diff --git a/some-file b/some-file$`)),
+					resource.TestMatchResourceAttr("data.git_diff.test", "patch", regexp.MustCompile(`(?m)^\+some changes$`)),
+					resource.TestMatchResourceAttr("data.git_diff.test", "patch", regexp.MustCompile(`(?m)^deleted file mode 100644$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitDiff_Cached(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.WriteFileInWorktree(t, worktree, "staged-file")
+	testutils.GitAdd(t, worktree, "staged-file")
+	testutils.WriteFileContent(t, testutils.FileInWorktree(worktree, "some-file"), "unstaged changes\n")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_diff" "test" {
+						directory = "%s"
+						cached    = true
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_diff.test", "cached", "true"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.%", "1"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.staged-file.status", "added"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.staged-file.additions", "1"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.staged-file.deletions", "0"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "additions", "1"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "deletions", "0"),
+					resource.TestMatchResourceAttr("data.git_diff.test", "patch", regexp.MustCompile(`(?m)^new file mode 100644$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitDiff_EmptyRepository(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.WriteFileInWorktree(t, worktree, "some-file")
+	testutils.GitAdd(t, worktree, "some-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_diff" "test" {
+						directory = "%s"
+						cached    = true
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.%", "1"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.some-file.status", "added"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitDiff_Revisions(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.WriteFileContent(t, testutils.FileInWorktree(worktree, "deleted-file"), "deleted content\n")
+	testutils.GitAdd(t, worktree, "deleted-file")
+	testutils.GitCommit(t, worktree)
+	if _, err := worktree.Move("some-file", "renamed-file"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := worktree.Remove("deleted-file"); err != nil {
+		t.Fatal(err)
+	}
+	testutils.GitCommit(t, worktree)
+	testutils.WriteFileContent(t, testutils.FileInWorktree(worktree, "renamed-file"), "uncommitted changes\n")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_diff" "test" {
+						directory = "%s"
+						from      = "HEAD~1"
+						to        = "HEAD"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_diff.test", "from", "HEAD~1"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "to", "HEAD"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.%", "2"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.renamed-file.status", "renamed"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.renamed-file.old_path", "some-file"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.renamed-file.additions", "0"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.renamed-file.deletions", "0"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.deleted-file.status", "deleted"),
+					resource.TestMatchResourceAttr("data.git_diff.test", "patch", regexp.MustCompile(`(?m)^rename from some-file$`)),
+					resource.TestMatchResourceAttr("data.git_diff.test", "patch", regexp.MustCompile(`(?m)^rename to renamed-file$`)),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "git_diff" "test" {
+						directory = "%s"
+						from      = "HEAD~1"
+						to        = "HEAD"
+						renames   = false
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.%", "3"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.some-file.status", "deleted"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.renamed-file.status", "added"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.deleted-file.status", "deleted"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "git_diff" "test" {
+						directory = "%s"
+						from      = "HEAD"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.%", "1"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.renamed-file.status", "modified"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.renamed-file.additions", "1"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.renamed-file.deletions", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitDiff_Paths(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	if err := os.MkdirAll(testutils.FileInWorktree(worktree, "nested/directory"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	testutils.WriteFileInWorktree(t, worktree, "nested/directory/file.txt")
+	testutils.WriteFileInWorktree(t, worktree, "other-file.txt")
+	testutils.GitAdd(t, worktree, "nested/directory/file.txt")
+	testutils.GitAdd(t, worktree, "other-file.txt")
+	testutils.GitCommit(t, worktree)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_diff" "test" {
+						directory = "%s"
+						from      = "HEAD~1"
+						to        = "HEAD"
+						paths     = ["nested/**"]
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_diff.test", "paths.#", "1"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "paths.0", "nested/**"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.%", "1"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.nested/directory/file.txt.status", "added"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "additions", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "git_diff" "test" {
+						directory = "%s"
+						from      = "HEAD~1"
+						to        = "HEAD"
+						paths     = ["*.md"]
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_diff.test", "files.%", "0"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "additions", "0"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "deletions", "0"),
+					resource.TestCheckResourceAttr("data.git_diff.test", "patch", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitDiff_InvalidPattern(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_diff" "test" {
+						directory = "%s"
+						paths     = ["["]
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot match file path`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitDiff_CachedWithTo(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_diff" "test" {
+						directory = "%s"
+						to        = "HEAD"
+						cached    = true
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Invalid diff options`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitDiff_UnknownRevision(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_diff" "test" {
+						directory = "%s"
+						from      = "does-not-exist"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot resolve revision`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitDiff_BareRepository(t *testing.T) {
+	t.Parallel()
+	directory := testutils.CreateBareRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_diff" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot diff bare repository`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitDiff_InvalidRepository(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "git_diff" "test" {
+						directory = "/some/random/path"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Cannot open repository`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitDiff_MissingRepository(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "git_diff" "test" {}
+				`,
+				ExpectError: regexp.MustCompile(`Missing required argument`),
+			},
+		},
+	})
+}
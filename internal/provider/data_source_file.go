@@ -0,0 +1,169 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"path/filepath"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type FileDataSource struct{}
+
+var (
+	_ datasource.DataSource = (*FileDataSource)(nil)
+)
+
+type fileDataSourceModel struct {
+	Directory     types.String `tfsdk:"directory"`
+	Id            types.String `tfsdk:"id"`
+	Revision      types.String `tfsdk:"revision"`
+	Path          types.String `tfsdk:"path"`
+	Content       types.String `tfsdk:"content"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	Size          types.Int64  `tfsdk:"size"`
+	Mode          types.String `tfsdk:"mode"`
+	SHA1          types.String `tfsdk:"sha1"`
+}
+
+func NewFileDataSource() datasource.DataSource {
+	return &FileDataSource{}
+}
+
+func (d *FileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file"
+}
+
+func (d *FileDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Fetches a file at a given revision of a Git repository similar to 'git show revision:path'. The file is read from the object database and thus works in bare repositories as well.",
+		MarkdownDescription: "Fetches a file at a given revision of a Git repository similar to `git show revision:path`. The file is read from the object database and thus works in bare repositories as well.",
+		Attributes: map[string]schema.Attribute{
+			"directory": schema.StringAttribute{
+				Description:         "The path to the local Git repository.",
+				MarkdownDescription: "The path to the local Git repository.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description:         "The revision and path of the file in the form 'revision:path'.",
+				MarkdownDescription: "The revision and path of the file in the form `revision:path`.",
+				Computed:            true,
+			},
+			"revision": schema.StringAttribute{
+				Description:         "The revision of the commit to read the file from. Note that 'go-git' does not support every revision type at the moment. See https://pkg.go.dev/github.com/go-git/go-git/v5#Repository.ResolveRevision for details.",
+				MarkdownDescription: "The [revision](https://www.git-scm.com/docs/gitrevisions) of the commit to read the file from. Note that `go-git` does not [support](https://pkg.go.dev/github.com/go-git/go-git/v5#Repository.ResolveRevision) every revision type at the moment.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description:         "The path of the file relative to the root of the repository.",
+				MarkdownDescription: "The path of the file relative to the root of the repository.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description:         "The UTF-8 encoded content of the file. Not set in case the file is not valid UTF-8, use 'content_base64' instead.",
+				MarkdownDescription: "The UTF-8 encoded content of the file. Not set in case the file is not valid UTF-8, use `content_base64` instead.",
+				Computed:            true,
+			},
+			"content_base64": schema.StringAttribute{
+				Description:         "The base64 encoded content of the file.",
+				MarkdownDescription: "The base64 encoded content of the file.",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				Description:         "The size of the file in bytes.",
+				MarkdownDescription: "The size of the file in bytes.",
+				Computed:            true,
+			},
+			"mode": schema.StringAttribute{
+				Description:         "The mode of the file as stored by Git, e.g. '0100644' for regular files, '0100755' for executable files, and '0120000' for symbolic links.",
+				MarkdownDescription: "The mode of the file as stored by Git, e.g. `0100644` for regular files, `0100755` for executable files, and `0120000` for symbolic links.",
+				Computed:            true,
+			},
+			"sha1": schema.StringAttribute{
+				Description:         "The SHA1 hash of the blob of the file.",
+				MarkdownDescription: "The SHA1 hash of the blob of the file.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *FileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Read data source git_file")
+
+	var inputs fileDataSourceModel
+
+	diags := req.Config.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := inputs.Directory.ValueString()
+	revision := inputs.Revision.ValueString()
+	filePath := filepath.ToSlash(filepath.Clean(inputs.Path.ValueString()))
+
+	if !filepath.IsLocal(filePath) {
+		resp.Diagnostics.AddError(
+			"Invalid file path",
+			"The path ["+filePath+"] must be relative to the root of the repository and must not escape it.",
+		)
+		return
+	}
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	tree := revisionTree(ctx, repository, revision, &resp.Diagnostics)
+	if tree == nil {
+		return
+	}
+
+	file, content := readTreeFile(ctx, tree, filePath, &resp.Diagnostics)
+	if file == nil {
+		return
+	}
+
+	var state fileDataSourceModel
+	state.Directory = inputs.Directory
+	state.Id = types.StringValue(fmt.Sprintf("%s:%s", revision, filePath))
+	state.Revision = inputs.Revision
+	state.Path = inputs.Path
+	state.Content = types.StringNull()
+	if utf8.Valid(content) {
+		state.Content = types.StringValue(string(content))
+	}
+	state.ContentBase64 = types.StringValue(base64.StdEncoding.EncodeToString(content))
+	state.Size = types.Int64Value(file.Size)
+	state.Mode = types.StringValue(file.Mode.String())
+	state.SHA1 = types.StringValue(file.Hash.String())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,326 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/metio/terraform-provider-git/internal/testutils"
+)
+
+func TestDataSourceGitFile(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	sha1 := plumbing.ComputeHash(plumbing.BlobObject, []byte("hello world!")).String()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_file" "test" {
+						directory = "%s"
+						revision  = "HEAD"
+						path      = "some-file"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_file.test", "directory", directory),
+					resource.TestCheckResourceAttr("data.git_file.test", "id", "HEAD:some-file"),
+					resource.TestCheckResourceAttr("data.git_file.test", "revision", "HEAD"),
+					resource.TestCheckResourceAttr("data.git_file.test", "path", "some-file"),
+					resource.TestCheckResourceAttr("data.git_file.test", "content", "hello world!"),
+					resource.TestCheckResourceAttr("data.git_file.test", "content_base64", "aGVsbG8gd29ybGQh"),
+					resource.TestCheckResourceAttr("data.git_file.test", "size", "12"),
+					resource.TestCheckResourceAttr("data.git_file.test", "mode", "0100644"),
+					resource.TestCheckResourceAttr("data.git_file.test", "sha1", sha1),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitFile_Revision(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "versions.json")
+	testutils.CreateTag(t, repository, "v1.0.0")
+	testutils.WriteFileContent(t, testutils.FileInWorktree(worktree, "versions.json"), `{"version":"2.0.0"}`)
+	testutils.GitAdd(t, worktree, "versions.json")
+	testutils.GitCommit(t, worktree)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_file" "test" {
+						directory = "%s"
+						revision  = "v1.0.0"
+						path      = "versions.json"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_file.test", "id", "v1.0.0:versions.json"),
+					resource.TestCheckResourceAttr("data.git_file.test", "content", "hello world!"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "git_file" "test" {
+						directory = "%s"
+						revision  = "master"
+						path      = "versions.json"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_file.test", "content", `{"version":"2.0.0"}`),
+					resource.TestCheckResourceAttr("data.git_file.test", "size", "19"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitFile_Nested(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	if err := os.MkdirAll(testutils.FileInWorktree(worktree, "nested/directory"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	testutils.AddAndCommitNewFile(t, worktree, "nested/directory/some-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_file" "test" {
+						directory = "%s"
+						revision  = "HEAD"
+						path      = "nested/directory/some-file"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_file.test", "content", "hello world!"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "git_file" "test" {
+						directory = "%s"
+						revision  = "HEAD"
+						path      = "nested/directory"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot read file`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitFile_Executable(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.WriteFileInWorktree(t, worktree, "some-script")
+	if err := os.Chmod(testutils.FileInWorktree(worktree, "some-script"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	testutils.GitAdd(t, worktree, "some-script")
+	testutils.GitCommit(t, worktree)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_file" "test" {
+						directory = "%s"
+						revision  = "HEAD"
+						path      = "some-script"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_file.test", "mode", "0100755"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitFile_Binary(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.WriteFileContent(t, testutils.FileInWorktree(worktree, "some-binary"), "\xff\x00binary")
+	testutils.GitAdd(t, worktree, "some-binary")
+	testutils.GitCommit(t, worktree)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_file" "test" {
+						directory = "%s"
+						revision  = "HEAD"
+						path      = "some-binary"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("data.git_file.test", "content"),
+					resource.TestCheckResourceAttr("data.git_file.test", "content_base64", "/wBiaW5hcnk="),
+					resource.TestCheckResourceAttr("data.git_file.test", "size", "8"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitFile_BareRepository(t *testing.T) {
+	t.Parallel()
+	_, repository := testutils.CreateRepository(t)
+	directory := testutils.CreateBareRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.CreateRemoteWithUrls(t, repository, "origin", []string{filepath.FromSlash(directory)})
+	testutils.GitPush(t, repository, "refs/heads/master:refs/heads/master")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_file" "test" {
+						directory = "%s"
+						revision  = "master"
+						path      = "some-file"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_file.test", "content", "hello world!"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitFile_MissingFile(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_file" "test" {
+						directory = "%s"
+						revision  = "HEAD"
+						path      = "other-file"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot read file`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitFile_InvalidPath(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_file" "test" {
+						directory = "%s"
+						revision  = "HEAD"
+						path      = "../some-file"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Invalid file path`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitFile_UnknownRevision(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_file" "test" {
+						directory = "%s"
+						revision  = "does-not-exist"
+						path      = "some-file"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot resolve revision`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitFile_InvalidRepository(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "git_file" "test" {
+						directory = "/some/random/path"
+						revision  = "HEAD"
+						path      = "some-file"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Cannot open repository`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitFile_MissingRepository(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "git_file" "test" {
+						revision = "HEAD"
+						path     = "some-file"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Missing required argument`),
+			},
+		},
+	})
+}
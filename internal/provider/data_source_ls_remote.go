@@ -0,0 +1,191 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type LsRemoteDataSource struct {
+	defaults *GitProviderModel
+}
+
+var (
+	_ datasource.DataSource              = (*LsRemoteDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*LsRemoteDataSource)(nil)
+)
+
+type lsRemoteDataSourceModel struct {
+	URL              types.String `tfsdk:"url"`
+	Id               types.String `tfsdk:"id"`
+	Pattern          types.String `tfsdk:"pattern"`
+	InsecureSkipTls  types.Bool   `tfsdk:"insecure_skip_tls"`
+	CaBundleFilePath types.String `tfsdk:"ca_bundle_file_path"`
+	Auth             types.Object `tfsdk:"auth"`
+	Head             types.String `tfsdk:"head"`
+	Refs             types.Map    `tfsdk:"refs"`
+	Peeled           types.Map    `tfsdk:"peeled"`
+}
+
+func NewLsRemoteDataSource() datasource.DataSource {
+	return &LsRemoteDataSource{}
+}
+
+func (d *LsRemoteDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ls_remote"
+}
+
+func (d *LsRemoteDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.defaults = providerDefaults(req.ProviderData, &resp.Diagnostics)
+}
+
+func (d *LsRemoteDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Fetches the references of a remote Git repository similar to 'git ls-remote'. No local repository is required since the references are listed in memory.",
+		MarkdownDescription: "Fetches the references of a remote Git repository similar to `git ls-remote`. No local repository is required since the references are listed in memory.",
+		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Description:         "The (possibly remote) repository URL to list references of.",
+				MarkdownDescription: "The (possibly remote) repository URL to list references of.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description:         "The same value as the 'url' attribute.",
+				MarkdownDescription: "The same value as the `url` attribute.",
+				Computed:            true,
+			},
+			"pattern": schema.StringAttribute{
+				Description:         "Only list references whose full name matches the given glob pattern, e.g. 'refs/heads/**' for branches or 'refs/tags/v1.*' for tags. Defaults to all references.",
+				MarkdownDescription: "Only list references whose full name matches the given glob pattern, e.g. `refs/heads/**` for branches or `refs/tags/v1.*` for tags. Defaults to all references.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"insecure_skip_tls": schema.BoolAttribute{
+				Description:         "Skip SSL verification if protocol is HTTPS.",
+				MarkdownDescription: "Skip SSL verification if protocol is HTTPS.",
+				Optional:            true,
+			},
+			"ca_bundle_file_path": schema.StringAttribute{
+				Description:         "File system path to an additional CA bundle to use together with the system cert pool.",
+				MarkdownDescription: "File system path to an additional CA bundle to use together with the system cert pool.",
+				Optional:            true,
+			},
+			"auth": authDataSourceAttribute(),
+			"head": schema.StringAttribute{
+				Description:         "The name of the reference the 'HEAD' of the remote repository points to, e.g. 'refs/heads/main'. Not set in case the remote repository is empty or does not advertise its 'HEAD'.",
+				MarkdownDescription: "The name of the reference the `HEAD` of the remote repository points to, e.g. `refs/heads/main`. Not set in case the remote repository is empty or does not advertise its `HEAD`.",
+				Computed:            true,
+			},
+			"refs": schema.MapAttribute{
+				Description:         "The SHA1 hashes of the references of the remote repository keyed by their full name.",
+				MarkdownDescription: "The SHA1 hashes of the references of the remote repository keyed by their full name.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"peeled": schema.MapAttribute{
+				Description:         "The SHA1 hashes of the objects annotated tags point to keyed by the full name of the tag. Lightweight tags are not included since they point to their target directly.",
+				MarkdownDescription: "The SHA1 hashes of the objects annotated tags point to keyed by the full name of the tag. Lightweight tags are not included since they point to their target directly.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *LsRemoteDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Read data source git_ls_remote")
+
+	var inputs lsRemoteDataSourceModel
+	var state lsRemoteDataSourceModel
+
+	diags := req.Config.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pattern := inputs.Pattern.ValueString()
+	if pattern != "" && !doublestar.ValidatePattern(pattern) {
+		resp.Diagnostics.AddError(
+			"Cannot match reference",
+			"The pattern ["+pattern+"] is not a valid glob pattern",
+		)
+		return
+	}
+
+	url := inputs.URL.ValueString()
+	options := createListOptions(ctx, &inputs, d.defaults, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refs := listRemoteReferences(ctx, url, options, &resp.Diagnostics)
+	if refs == nil {
+		return
+	}
+
+	head := types.StringNull()
+	allRefs := make(map[string]attr.Value)
+	allPeeled := make(map[string]attr.Value)
+	for _, ref := range refs {
+		name := ref.Name().String()
+		if ref.Type() == plumbing.SymbolicReference {
+			if ref.Name() == plumbing.HEAD {
+				head = types.StringValue(ref.Target().String())
+			}
+			target := findRemoteReference(refs, ref.Target())
+			if target == nil || !matchesReference(name, pattern) {
+				continue
+			}
+			allRefs[name] = types.StringValue(target.Hash().String())
+		} else if tag, ok := strings.CutSuffix(name, peeledSuffix); ok {
+			if matchesReference(tag, pattern) {
+				allPeeled[tag] = types.StringValue(ref.Hash().String())
+			}
+		} else if matchesReference(name, pattern) {
+			allRefs[name] = types.StringValue(ref.Hash().String())
+		}
+	}
+
+	tflog.Trace(ctx, "read remote references", map[string]interface{}{
+		"url":     url,
+		"pattern": pattern,
+		"refs":    len(allRefs),
+		"peeled":  len(allPeeled),
+	})
+
+	state.URL = inputs.URL
+	state.Id = inputs.URL
+	state.Pattern = inputs.Pattern
+	state.InsecureSkipTls = inputs.InsecureSkipTls
+	state.CaBundleFilePath = inputs.CaBundleFilePath
+	state.Auth = inputs.Auth
+	state.Head = head
+	state.Refs = types.MapValueMust(types.StringType, allRefs)
+	state.Peeled = types.MapValueMust(types.StringType, allPeeled)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,202 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/metio/terraform-provider-git/internal/testutils"
+)
+
+func TestDataSourceGitLsRemote(t *testing.T) {
+	t.Parallel()
+	_, repository := testutils.CreateRepository(t)
+	directory := testutils.CreateBareRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.CreateTag(t, repository, "v1.0.0")
+	testutils.CreateTagWith(t, repository, "lightweight", nil)
+	testutils.CreateRemoteWithUrls(t, repository, "origin", []string{filepath.FromSlash(directory)})
+	testutils.GitPush(t, repository, "refs/heads/master:refs/heads/master", "refs/tags/*:refs/tags/*")
+	head := testutils.GetRepositoryHead(t, repository)
+	tag, err := repository.Tag("v1.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_ls_remote" "test" {
+						url = "%s"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_ls_remote.test", "url", directory),
+					resource.TestCheckResourceAttr("data.git_ls_remote.test", "id", directory),
+					resource.TestCheckNoResourceAttr("data.git_ls_remote.test", "pattern"),
+					resource.TestCheckResourceAttr("data.git_ls_remote.test", "head", "refs/heads/master"),
+					resource.TestCheckResourceAttr("data.git_ls_remote.test", "refs.%", "4"),
+					resource.TestCheckResourceAttr("data.git_ls_remote.test", "refs.HEAD", head.Hash().String()),
+					resource.TestCheckResourceAttr("data.git_ls_remote.test", "refs.refs/heads/master", head.Hash().String()),
+					resource.TestCheckResourceAttr("data.git_ls_remote.test", "refs.refs/tags/lightweight", head.Hash().String()),
+					resource.TestCheckResourceAttr("data.git_ls_remote.test", "refs.refs/tags/v1.0.0", tag.Hash().String()),
+					resource.TestCheckResourceAttr("data.git_ls_remote.test", "peeled.%", "1"),
+					resource.TestCheckResourceAttr("data.git_ls_remote.test", "peeled.refs/tags/v1.0.0", head.Hash().String()),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitLsRemote_Pattern(t *testing.T) {
+	t.Parallel()
+	_, repository := testutils.CreateRepository(t)
+	directory := testutils.CreateBareRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.CreateTag(t, repository, "v1.0.0")
+	testutils.CreateTagWith(t, repository, "lightweight", nil)
+	testutils.CreateRemoteWithUrls(t, repository, "origin", []string{filepath.FromSlash(directory)})
+	testutils.GitPush(t, repository, "refs/heads/master:refs/heads/master", "refs/tags/*:refs/tags/*")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_ls_remote" "test" {
+						url     = "%s"
+						pattern = "refs/tags/*"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_ls_remote.test", "pattern", "refs/tags/*"),
+					resource.TestCheckResourceAttr("data.git_ls_remote.test", "head", "refs/heads/master"),
+					resource.TestCheckResourceAttr("data.git_ls_remote.test", "refs.%", "2"),
+					resource.TestCheckResourceAttrWith("data.git_ls_remote.test", "refs.refs/tags/lightweight", testutils.CheckExactLength(40)),
+					resource.TestCheckResourceAttrWith("data.git_ls_remote.test", "refs.refs/tags/v1.0.0", testutils.CheckExactLength(40)),
+					resource.TestCheckResourceAttr("data.git_ls_remote.test", "peeled.%", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "git_ls_remote" "test" {
+						url     = "%s"
+						pattern = "refs/heads/**"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_ls_remote.test", "refs.%", "1"),
+					resource.TestCheckResourceAttrWith("data.git_ls_remote.test", "refs.refs/heads/master", testutils.CheckExactLength(40)),
+					resource.TestCheckResourceAttr("data.git_ls_remote.test", "peeled.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitLsRemote_EmptyRepository(t *testing.T) {
+	t.Parallel()
+	directory := testutils.CreateBareRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_ls_remote" "test" {
+						url = "%s"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("data.git_ls_remote.test", "head"),
+					resource.TestCheckResourceAttr("data.git_ls_remote.test", "refs.%", "0"),
+					resource.TestCheckResourceAttr("data.git_ls_remote.test", "peeled.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitLsRemote_InvalidPattern(t *testing.T) {
+	t.Parallel()
+	directory := testutils.CreateBareRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_ls_remote" "test" {
+						url     = "%s"
+						pattern = "["
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot match reference`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitLsRemote_InvalidCaBundle(t *testing.T) {
+	t.Parallel()
+	directory := testutils.CreateBareRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_ls_remote" "test" {
+						url                 = "%s"
+						ca_bundle_file_path = "/some/random/path"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Invalid CA bundle file path`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitLsRemote_InvalidUrl(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "git_ls_remote" "test" {
+						url = "/some/random/path"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Cannot list remote`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitLsRemote_MissingUrl(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "git_ls_remote" "test" {
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Missing required argument`),
+			},
+		},
+	})
+}
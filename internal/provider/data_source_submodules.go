@@ -0,0 +1,184 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type SubmodulesDataSource struct{}
+
+var (
+	_ datasource.DataSource = (*SubmodulesDataSource)(nil)
+)
+
+type submodulesDataSourceModel struct {
+	Directory  types.String `tfsdk:"directory"`
+	Id         types.String `tfsdk:"id"`
+	Submodules types.Map    `tfsdk:"submodules"`
+}
+
+func NewSubmodulesDataSource() datasource.DataSource {
+	return &SubmodulesDataSource{}
+}
+
+func (d *SubmodulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_submodules"
+}
+
+func (d *SubmodulesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Reads all submodules declared in the '.gitmodules' file of a Git repository similar to 'git submodule status'.",
+		MarkdownDescription: "Reads all submodules declared in the `.gitmodules` file of a Git repository similar to `git submodule status`.",
+		Attributes: map[string]schema.Attribute{
+			"directory": schema.StringAttribute{
+				Description:         "The path to the local Git repository.",
+				MarkdownDescription: "The path to the local Git repository.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description:         "The same value as the 'directory' attribute.",
+				MarkdownDescription: "The same value as the `directory` attribute.",
+				Computed:            true,
+			},
+			"submodules": schema.MapNestedAttribute{
+				Description:         "All submodules of the given Git repository keyed by their name.",
+				MarkdownDescription: "All submodules of the given Git repository keyed by their name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Description:         "The path of the submodule relative to the root of the worktree.",
+							MarkdownDescription: "The path of the submodule relative to the root of the worktree.",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							Description:         "The URL of the repository of the submodule.",
+							MarkdownDescription: "The URL of the repository of the submodule.",
+							Computed:            true,
+						},
+						"branch": schema.StringAttribute{
+							Description:         "The remote branch tracked by the submodule, if any.",
+							MarkdownDescription: "The remote branch tracked by the submodule, if any.",
+							Computed:            true,
+						},
+						"expected_sha1": schema.StringAttribute{
+							Description:         "The SHA1 hash of the commit recorded for the submodule in the index.",
+							MarkdownDescription: "The SHA1 hash of the commit recorded for the submodule in the index.",
+							Computed:            true,
+						},
+						"actual_sha1": schema.StringAttribute{
+							Description:         "The SHA1 hash of the commit checked out in the submodule. Not set in case the submodule is not initialized.",
+							MarkdownDescription: "The SHA1 hash of the commit checked out in the submodule. Not set in case the submodule is not initialized.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SubmodulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Read data source git_submodules")
+
+	var inputs submodulesDataSourceModel
+	var state submodulesDataSourceModel
+
+	diags := req.Config.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := inputs.Directory.ValueString()
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	submoduleType := map[string]attr.Type{
+		"path":          types.StringType,
+		"url":           types.StringType,
+		"branch":        types.StringType,
+		"expected_sha1": types.StringType,
+		"actual_sha1":   types.StringType,
+	}
+
+	allSubmodules := make(map[string]attr.Value)
+
+	worktree, err := getWorktree(repository, &resp.Diagnostics)
+	if err != nil {
+		return
+	} else if worktree != nil {
+		submodules := getSubmodules(ctx, worktree, types.StringNull(), &resp.Diagnostics)
+		if submodules == nil {
+			return
+		}
+
+		statuses := getSubmoduleStatuses(ctx, submodules, &resp.Diagnostics)
+		if statuses == nil {
+			return
+		}
+
+		for _, submodule := range submodules {
+			cfg := submodule.Config()
+			status := statuses[cfg.Name]
+
+			branch := types.StringNull()
+			if cfg.Branch != "" {
+				branch = types.StringValue(cfg.Branch)
+			}
+			actual := types.StringNull()
+			if !status.Current.IsZero() {
+				actual = types.StringValue(status.Current.String())
+			}
+
+			allSubmodules[cfg.Name] = types.ObjectValueMust(
+				submoduleType,
+				map[string]attr.Value{
+					"path":          types.StringValue(cfg.Path),
+					"url":           types.StringValue(cfg.URL),
+					"branch":        branch,
+					"expected_sha1": types.StringValue(status.Expected.String()),
+					"actual_sha1":   actual,
+				},
+			)
+		}
+	}
+
+	tflog.Trace(ctx, "read submodules", map[string]interface{}{
+		"directory":  directory,
+		"submodules": len(allSubmodules),
+	})
+
+	state.Directory = inputs.Directory
+	state.Id = inputs.Directory
+	state.Submodules = types.MapValueMust(
+		types.ObjectType{
+			AttrTypes: submoduleType,
+		},
+		allSubmodules,
+	)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
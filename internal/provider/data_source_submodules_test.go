@@ -0,0 +1,110 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/metio/terraform-provider-git/internal/testutils"
+)
+
+func TestDataSourceGitSubmodules(t *testing.T) {
+	t.Parallel()
+	submoduleDirectory, submoduleRepository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, submoduleRepository)
+	submoduleWorktree := testutils.GetRepositoryWorktree(t, submoduleRepository)
+	testutils.AddAndCommitNewFile(t, submoduleWorktree, "some-file")
+	submoduleHead := testutils.GetRepositoryHead(t, submoduleRepository)
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	testutils.AddSubmodule(t, repository, "library", "vendor/library", submoduleDirectory, submoduleHead.Hash())
+	testutils.GitCommit(t, testutils.GetRepositoryWorktree(t, repository))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_submodules" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_submodules.test", "directory", directory),
+					resource.TestCheckResourceAttr("data.git_submodules.test", "id", directory),
+					resource.TestCheckResourceAttr("data.git_submodules.test", "submodules.%", "1"),
+					resource.TestCheckResourceAttr("data.git_submodules.test", "submodules.library.path", "vendor/library"),
+					resource.TestCheckResourceAttr("data.git_submodules.test", "submodules.library.url", submoduleDirectory),
+					resource.TestCheckNoResourceAttr("data.git_submodules.test", "submodules.library.branch"),
+					resource.TestCheckResourceAttr("data.git_submodules.test", "submodules.library.expected_sha1", submoduleHead.Hash().String()),
+					resource.TestCheckNoResourceAttr("data.git_submodules.test", "submodules.library.actual_sha1"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitSubmodules_NoSubmodules(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_submodules" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_submodules.test", "submodules.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitSubmodules_BareRepository(t *testing.T) {
+	t.Parallel()
+	directory := testutils.CreateBareRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_submodules" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_submodules.test", "submodules.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitSubmodules_InvalidRepository(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "git_submodules" "test" {
+						directory = "/some/random/path"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Cannot open repository`),
+			},
+		},
+	})
+}
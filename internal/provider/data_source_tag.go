@@ -30,6 +30,10 @@ type tagDataSourceModel struct {
 	Annotated   types.Bool   `tfsdk:"annotated"`
 	SHA1        types.String `tfsdk:"sha1"`
 	Message     types.String `tfsdk:"message"`
+	Tagger      types.Object `tfsdk:"tagger"`
+	Signature   types.String `tfsdk:"signature"`
+	Keyring     types.String `tfsdk:"keyring"`
+	Verified    types.Bool   `tfsdk:"verified"`
 }
 
 func NewTagDataSource() datasource.DataSource {
@@ -86,6 +90,46 @@ func (d *TagDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, re
 				MarkdownDescription: "The associated message of an annotated tag.",
 				Computed:            true,
 			},
+			"tagger": schema.SingleNestedAttribute{
+				Description:         "The person who created an annotated tag.",
+				MarkdownDescription: "The person who created an annotated tag.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description:         "The name of the tagger.",
+						MarkdownDescription: "The name of the tagger.",
+						Computed:            true,
+					},
+					"email": schema.StringAttribute{
+						Description:         "The email address of the tagger.",
+						MarkdownDescription: "The email address of the tagger.",
+						Computed:            true,
+					},
+					"timestamp": schema.StringAttribute{
+						Description:         "The timestamp of the signature.",
+						MarkdownDescription: "The timestamp of the signature.",
+						Computed:            true,
+					},
+				},
+			},
+			"signature": schema.StringAttribute{
+				Description:         "The signature of an annotated tag.",
+				MarkdownDescription: "The signature of an annotated tag.",
+				Computed:            true,
+			},
+			"keyring": schema.StringAttribute{
+				Description:         "The armored OpenPGP public keys to verify the signature of the tag with.",
+				MarkdownDescription: "The armored OpenPGP public keys to verify the signature of the tag with.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"verified": schema.BoolAttribute{
+				Description:         "Whether the tag carries a valid signature of one of the keys in 'keyring'. Only set in case 'keyring' is specified.",
+				MarkdownDescription: "Whether the tag carries a valid signature of one of the keys in `keyring`. Only set in case `keyring` is specified.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -123,14 +167,27 @@ func (d *TagDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	state.Id = inputs.Name
 	state.Name = inputs.Name
 	state.SHA1 = types.StringValue(tagReference.Hash().String())
+	state.Keyring = inputs.Keyring
 	if tagObject == nil {
 		state.Annotated = types.BoolValue(false)
 		state.Lightweight = types.BoolValue(true)
 		state.Message = types.StringNull()
+		state.Tagger = signatureToObject(nil)
+		state.Signature = types.StringNull()
 	} else {
 		state.Annotated = types.BoolValue(true)
 		state.Lightweight = types.BoolValue(false)
 		state.Message = types.StringValue(tagObject.Message)
+		state.Tagger = signatureToObject(&tagObject.Tagger)
+		state.Signature = types.StringValue(tagObject.PGPSignature)
+	}
+	state.Verified = types.BoolNull()
+	if !inputs.Keyring.IsNull() {
+		keyring := readKeyring(inputs.Keyring.ValueString(), &resp.Diagnostics)
+		if keyring == nil {
+			return
+		}
+		state.Verified = types.BoolValue(verifyTagSignature(ctx, tagObject, keyring))
 	}
 
 	diags = resp.State.Set(ctx, &state)
@@ -10,6 +10,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/go-git/go-git/v5"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/metio/terraform-provider-git/internal/testutils"
 )
@@ -45,6 +46,111 @@ func TestDataSourceGitTag(t *testing.T) {
 	})
 }
 
+func TestDataSourceGitTag_Lightweight(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	tag := "some-tag"
+	testutils.CreateTagWith(t, repository, tag, nil)
+	_, _, publicKey := testutils.OpenPGPKey(t, "")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_tag" "test" {
+						directory = "%s"
+						name      = "%s"
+						keyring   = <<EOT
+%sEOT
+					}
+				`, directory, tag, publicKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_tag.test", "annotated", "false"),
+					resource.TestCheckResourceAttr("data.git_tag.test", "lightweight", "true"),
+					resource.TestCheckNoResourceAttr("data.git_tag.test", "message"),
+					resource.TestCheckNoResourceAttr("data.git_tag.test", "signature"),
+					resource.TestCheckNoResourceAttr("data.git_tag.test", "tagger.name"),
+					resource.TestCheckResourceAttr("data.git_tag.test", "verified", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitTag_Verified(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	tag := "some-tag"
+	entity, _, publicKey := testutils.OpenPGPKey(t, "")
+	_, _, otherPublicKey := testutils.OpenPGPKey(t, "")
+	testutils.CreateTagWith(t, repository, tag, &git.CreateTagOptions{
+		Message: tag,
+		Tagger:  testutils.Signature(),
+		SignKey: entity,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_tag" "signer" {
+						directory = "%s"
+						name      = "%s"
+						keyring   = <<EOT
+%sEOT
+					}
+
+					data "git_tag" "other" {
+						directory = "%s"
+						name      = "%s"
+						keyring   = <<EOT
+%sEOT
+					}
+				`, directory, tag, publicKey, directory, tag, otherPublicKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_tag.signer", "tagger.name", testutils.Signature().Name),
+					resource.TestCheckResourceAttr("data.git_tag.signer", "tagger.email", testutils.Signature().Email),
+					resource.TestCheckResourceAttrWith("data.git_tag.signer", "tagger.timestamp", testutils.CheckMinLength(1)),
+					resource.TestCheckResourceAttrWith("data.git_tag.signer", "signature", testutils.CheckMinLength(1)),
+					resource.TestCheckResourceAttr("data.git_tag.signer", "verified", "true"),
+					resource.TestCheckResourceAttr("data.git_tag.other", "verified", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitTag_InvalidKeyring(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	tag := "some-tag"
+	testutils.CreateTag(t, repository, tag)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_tag" "test" {
+						directory = "%s"
+						name      = "%s"
+						keyring   = "not a keyring"
+					}
+				`, directory, tag),
+				ExpectError: regexp.MustCompile(`Invalid keyring`),
+			},
+		},
+	})
+}
+
 func TestDataSourceGitTag_InvalidRepository(t *testing.T) {
 	t.Parallel()
 	resource.UnitTest(t, resource.TestCase{
@@ -8,6 +8,7 @@ package provider
 import (
 	"context"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -29,6 +30,7 @@ type tagsDataSourceModel struct {
 	Id          types.String `tfsdk:"id"`
 	Lightweight types.Bool   `tfsdk:"lightweight"`
 	Annotated   types.Bool   `tfsdk:"annotated"`
+	Keyring     types.String `tfsdk:"keyring"`
 	Tags        types.Map    `tfsdk:"tags"`
 }
 
@@ -70,6 +72,14 @@ func (d *TagsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Required:            false,
 				Optional:            true,
 			},
+			"keyring": schema.StringAttribute{
+				Description:         "The armored OpenPGP public keys to verify the signatures of annotated tags with.",
+				MarkdownDescription: "The armored OpenPGP public keys to verify the signatures of annotated tags with.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			"tags": schema.MapNestedAttribute{
 				Description:         "All existing tags.",
 				MarkdownDescription: "All existing tags.",
@@ -91,6 +101,38 @@ func (d *TagsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 							MarkdownDescription: "The SHA1 checksum of the commit the tag is pointing at.",
 							Computed:            true,
 						},
+						"tagger": schema.SingleNestedAttribute{
+							Description:         "The person who created an annotated tag.",
+							MarkdownDescription: "The person who created an annotated tag.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Description:         "The name of the tagger.",
+									MarkdownDescription: "The name of the tagger.",
+									Computed:            true,
+								},
+								"email": schema.StringAttribute{
+									Description:         "The email address of the tagger.",
+									MarkdownDescription: "The email address of the tagger.",
+									Computed:            true,
+								},
+								"timestamp": schema.StringAttribute{
+									Description:         "The timestamp of the signature.",
+									MarkdownDescription: "The timestamp of the signature.",
+									Computed:            true,
+								},
+							},
+						},
+						"signature": schema.StringAttribute{
+							Description:         "The signature of an annotated tag.",
+							MarkdownDescription: "The signature of an annotated tag.",
+							Computed:            true,
+						},
+						"verified": schema.BoolAttribute{
+							Description:         "Whether the tag carries a valid signature of one of the keys in 'keyring'. Only set in case 'keyring' is specified.",
+							MarkdownDescription: "Whether the tag carries a valid signature of one of the keys in `keyring`. Only set in case `keyring` is specified.",
+							Computed:            true,
+						},
 					},
 				},
 			},
@@ -138,10 +180,27 @@ func (d *TagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		inputs.Lightweight = types.BoolValue(true)
 	}
 
+	var keyring openpgp.EntityList
+	if !inputs.Keyring.IsNull() {
+		keyring = readKeyring(inputs.Keyring.ValueString(), &resp.Diagnostics)
+		if keyring == nil {
+			return
+		}
+	}
+
 	tagType := map[string]attr.Type{
 		"annotated":   types.BoolType,
 		"lightweight": types.BoolType,
 		"sha1":        types.StringType,
+		"tagger": types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"name":      types.StringType,
+				"email":     types.StringType,
+				"timestamp": types.StringType,
+			},
+		},
+		"signature": types.StringType,
+		"verified":  types.BoolType,
 	}
 
 	allTags := make(map[string]attr.Value)
@@ -151,6 +210,11 @@ func (d *TagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 			return err
 		}
 
+		verified := types.BoolNull()
+		if keyring != nil {
+			verified = types.BoolValue(verifyTagSignature(ctx, tagObject, keyring))
+		}
+
 		if inputs.Annotated.ValueBool() && tagObject != nil {
 			allTags[ref.Name().Short()] = types.ObjectValueMust(
 				tagType,
@@ -158,6 +222,9 @@ func (d *TagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 					"annotated":   types.BoolValue(true),
 					"lightweight": types.BoolValue(false),
 					"sha1":        types.StringValue(ref.Hash().String()),
+					"tagger":      signatureToObject(&tagObject.Tagger),
+					"signature":   types.StringValue(tagObject.PGPSignature),
+					"verified":    verified,
 				},
 			)
 		}
@@ -168,6 +235,9 @@ func (d *TagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 					"annotated":   types.BoolValue(false),
 					"lightweight": types.BoolValue(true),
 					"sha1":        types.StringValue(ref.Hash().String()),
+					"tagger":      signatureToObject(nil),
+					"signature":   types.StringNull(),
+					"verified":    verified,
 				},
 			)
 		}
@@ -185,6 +255,7 @@ func (d *TagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	state.Id = inputs.Directory
 	state.Annotated = inputs.Annotated
 	state.Lightweight = inputs.Lightweight
+	state.Keyring = inputs.Keyring
 	state.Tags = types.MapValueMust(
 		types.ObjectType{
 			AttrTypes: tagType,
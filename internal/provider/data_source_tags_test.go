@@ -10,6 +10,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/go-git/go-git/v5"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/metio/terraform-provider-git/internal/testutils"
 )
@@ -103,6 +104,43 @@ func TestDataSourceGitTags_NoLightweight(t *testing.T) {
 	})
 }
 
+func TestDataSourceGitTags_Verified(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	entity, _, publicKey := testutils.OpenPGPKey(t, "")
+	testutils.CreateTag(t, repository, "unsigned")
+	testutils.CreateTagWith(t, repository, "signed", &git.CreateTagOptions{
+		Message: "signed",
+		Tagger:  testutils.Signature(),
+		SignKey: entity,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_tags" "test" {
+						directory = "%s"
+						keyring   = <<EOT
+%sEOT
+					}
+				`, directory, publicKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_tags.test", "tags.%", "2"),
+					resource.TestCheckResourceAttr("data.git_tags.test", "tags.signed.tagger.name", testutils.Signature().Name),
+					resource.TestCheckResourceAttrWith("data.git_tags.test", "tags.signed.signature", testutils.CheckMinLength(1)),
+					resource.TestCheckResourceAttr("data.git_tags.test", "tags.signed.verified", "true"),
+					resource.TestCheckResourceAttr("data.git_tags.test", "tags.unsigned.signature", ""),
+					resource.TestCheckResourceAttr("data.git_tags.test", "tags.unsigned.verified", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestDataSourceGitTags_InvalidRepository(t *testing.T) {
 	t.Parallel()
 	resource.UnitTest(t, resource.TestCase{
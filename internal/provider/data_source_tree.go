@@ -0,0 +1,280 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type TreeDataSource struct{}
+
+var (
+	_ datasource.DataSource = (*TreeDataSource)(nil)
+)
+
+type treeDataSourceModel struct {
+	Directory types.String `tfsdk:"directory"`
+	Id        types.String `tfsdk:"id"`
+	Revision  types.String `tfsdk:"revision"`
+	Path      types.String `tfsdk:"path"`
+	Recursive types.Bool   `tfsdk:"recursive"`
+	Include   types.List   `tfsdk:"include"`
+	Exclude   types.List   `tfsdk:"exclude"`
+	SHA1      types.String `tfsdk:"sha1"`
+	Entries   types.Map    `tfsdk:"entries"`
+}
+
+func NewTreeDataSource() datasource.DataSource {
+	return &TreeDataSource{}
+}
+
+func (d *TreeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tree"
+}
+
+func (d *TreeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Fetches the files and directories at a given revision of a Git repository similar to 'git ls-tree'. The tree is read from the object database and thus works in bare repositories as well.",
+		MarkdownDescription: "Fetches the files and directories at a given revision of a Git repository similar to `git ls-tree`. The tree is read from the object database and thus works in bare repositories as well.",
+		Attributes: map[string]schema.Attribute{
+			"directory": schema.StringAttribute{
+				Description:         "The path to the local Git repository.",
+				MarkdownDescription: "The path to the local Git repository.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description:         "The revision and path of the tree in the form 'revision:path'.",
+				MarkdownDescription: "The revision and path of the tree in the form `revision:path`.",
+				Computed:            true,
+			},
+			"revision": schema.StringAttribute{
+				Description:         "The revision of the commit to list. Note that 'go-git' does not support every revision type at the moment. See https://pkg.go.dev/github.com/go-git/go-git/v5#Repository.ResolveRevision for details.",
+				MarkdownDescription: "The [revision](https://www.git-scm.com/docs/gitrevisions) of the commit to list. Note that `go-git` does not [support](https://pkg.go.dev/github.com/go-git/go-git/v5#Repository.ResolveRevision) every revision type at the moment.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description:         "The path of the directory to list relative to the root of the repository. Defaults to the root of the repository.",
+				MarkdownDescription: "The path of the directory to list relative to the root of the repository. Defaults to the root of the repository.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"recursive": schema.BoolAttribute{
+				Description:         "Whether to list the content of nested directories as well similar to 'git ls-tree -r -t'. Defaults to 'false'.",
+				MarkdownDescription: "Whether to list the content of nested directories as well similar to `git ls-tree -r -t`. Defaults to `false`.",
+				Optional:            true,
+			},
+			"include": schema.ListAttribute{
+				Description:         "Only list entries whose path matches one of the given glob patterns. Patterns are matched against the path relative to the root of the repository.",
+				MarkdownDescription: "Only list entries whose path matches one of the given glob patterns. Patterns are matched against the path relative to the root of the repository.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"exclude": schema.ListAttribute{
+				Description:         "Do not list entries whose path matches one of the given glob patterns. Takes precedence over 'include'.",
+				MarkdownDescription: "Do not list entries whose path matches one of the given glob patterns. Takes precedence over `include`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"sha1": schema.StringAttribute{
+				Description:         "The SHA1 hash of the listed tree.",
+				MarkdownDescription: "The SHA1 hash of the listed tree.",
+				Computed:            true,
+			},
+			"entries": schema.MapNestedAttribute{
+				Description:         "The entries of the tree keyed by their path relative to the root of the repository.",
+				MarkdownDescription: "The entries of the tree keyed by their path relative to the root of the repository.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description:         "The type of the entry. Possible values are 'blob' for files, 'tree' for directories, and 'commit' for submodules.",
+							MarkdownDescription: "The type of the entry. Possible values are `blob` for files, `tree` for directories, and `commit` for submodules.",
+							Computed:            true,
+						},
+						"mode": schema.StringAttribute{
+							Description:         "The mode of the entry as stored by Git, e.g. '0100644' for regular files or '0040000' for directories.",
+							MarkdownDescription: "The mode of the entry as stored by Git, e.g. `0100644` for regular files or `0040000` for directories.",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							Description:         "The size of the file in bytes. Not set for directories and submodules.",
+							MarkdownDescription: "The size of the file in bytes. Not set for directories and submodules.",
+							Computed:            true,
+						},
+						"sha1": schema.StringAttribute{
+							Description:         "The SHA1 hash of the object the entry points to.",
+							MarkdownDescription: "The SHA1 hash of the object the entry points to.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TreeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Read data source git_tree")
+
+	var inputs treeDataSourceModel
+	var state treeDataSourceModel
+
+	diags := req.Config.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if inputs.Recursive.IsNull() {
+		inputs.Recursive = types.BoolValue(false)
+	}
+
+	include := make([]string, 0)
+	if !inputs.Include.IsNull() {
+		resp.Diagnostics.Append(inputs.Include.ElementsAs(ctx, &include, false)...)
+	}
+	exclude := make([]string, 0)
+	if !inputs.Exclude.IsNull() {
+		resp.Diagnostics.Append(inputs.Exclude.ElementsAs(ctx, &exclude, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, patterns := range [][]string{include, exclude} {
+		for _, pattern := range patterns {
+			if !doublestar.ValidatePathPattern(pattern) {
+				resp.Diagnostics.AddError(
+					"Cannot match file path",
+					"The pattern ["+pattern+"] is not a valid glob pattern",
+				)
+				return
+			}
+		}
+	}
+
+	directory := inputs.Directory.ValueString()
+	revision := inputs.Revision.ValueString()
+	treePath := ""
+	if !inputs.Path.IsNull() {
+		treePath = filepath.ToSlash(filepath.Clean(inputs.Path.ValueString()))
+		if !filepath.IsLocal(treePath) {
+			resp.Diagnostics.AddError(
+				"Invalid tree path",
+				"The path ["+treePath+"] must be relative to the root of the repository and must not escape it.",
+			)
+			return
+		}
+		if treePath == "." {
+			treePath = ""
+		}
+	}
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	root := revisionTree(ctx, repository, revision, &resp.Diagnostics)
+	if root == nil {
+		return
+	}
+
+	tree := subTree(ctx, root, treePath, &resp.Diagnostics)
+	if tree == nil {
+		return
+	}
+
+	entries := listTree(ctx, tree, treePath, inputs.Recursive.ValueBool(), &resp.Diagnostics)
+	if entries == nil {
+		return
+	}
+
+	entryType := map[string]attr.Type{
+		"type": types.StringType,
+		"mode": types.StringType,
+		"size": types.Int64Type,
+		"sha1": types.StringType,
+	}
+
+	allEntries := make(map[string]attr.Value)
+	for _, entry := range entries {
+		if len(include) > 0 && !matchesPatterns(entry.path, include) {
+			continue
+		}
+		if len(exclude) > 0 && matchesPatterns(entry.path, exclude) {
+			continue
+		}
+		size := treeEntrySize(repository, entry.entry, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		allEntries[entry.path] = types.ObjectValueMust(
+			entryType,
+			map[string]attr.Value{
+				"type": types.StringValue(treeEntryType(entry.entry)),
+				"mode": types.StringValue(entry.entry.Mode.String()),
+				"size": size,
+				"sha1": types.StringValue(entry.entry.Hash.String()),
+			},
+		)
+	}
+
+	tflog.Trace(ctx, "read tree", map[string]interface{}{
+		"directory": directory,
+		"revision":  revision,
+		"path":      treePath,
+		"entries":   len(allEntries),
+	})
+
+	state.Directory = inputs.Directory
+	state.Id = types.StringValue(fmt.Sprintf("%s:%s", revision, treePath))
+	state.Revision = inputs.Revision
+	state.Path = inputs.Path
+	state.Recursive = inputs.Recursive
+	state.Include = inputs.Include
+	state.Exclude = inputs.Exclude
+	state.SHA1 = types.StringValue(tree.Hash.String())
+	state.Entries = types.MapValueMust(
+		types.ObjectType{
+			AttrTypes: entryType,
+		},
+		allEntries,
+	)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
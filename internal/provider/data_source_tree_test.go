@@ -0,0 +1,300 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/metio/terraform-provider-git/internal/testutils"
+)
+
+func TestDataSourceGitTree(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFiles(t, worktree, "README.md", "services/first/main.tf", "services/first/src/main.go", "services/second/main.tf")
+	head := testutils.GetRepositoryHead(t, repository)
+	commit, err := repository.CommitObject(head.Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_tree" "test" {
+						directory = "%s"
+						revision  = "HEAD"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_tree.test", "directory", directory),
+					resource.TestCheckResourceAttr("data.git_tree.test", "id", "HEAD:"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "revision", "HEAD"),
+					resource.TestCheckNoResourceAttr("data.git_tree.test", "path"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "recursive", "false"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "sha1", commit.TreeHash.String()),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.%", "2"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.README.md.type", "blob"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.README.md.mode", "0100644"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.README.md.size", "12"),
+					resource.TestCheckResourceAttrWith("data.git_tree.test", "entries.README.md.sha1", testutils.CheckExactLength(40)),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.services.type", "tree"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.services.mode", "0040000"),
+					resource.TestCheckNoResourceAttr("data.git_tree.test", "entries.services.size"),
+					resource.TestCheckResourceAttrWith("data.git_tree.test", "entries.services.sha1", testutils.CheckExactLength(40)),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitTree_Path(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFiles(t, worktree, "README.md", "services/first/main.tf", "services/first/src/main.go", "services/second/main.tf")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_tree" "test" {
+						directory = "%s"
+						revision  = "HEAD"
+						path      = "services"
+					}
+					data "git_tree" "root" {
+						directory = "%s"
+						revision  = "HEAD"
+					}
+				`, directory, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_tree.test", "id", "HEAD:services"),
+					resource.TestCheckResourceAttrPair("data.git_tree.test", "sha1", "data.git_tree.root", "entries.services.sha1"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.%", "2"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.services/first.type", "tree"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.services/second.type", "tree"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitTree_Recursive(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFiles(t, worktree, "README.md", "services/first/main.tf", "services/first/src/main.go", "services/second/main.tf")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_tree" "test" {
+						directory = "%s"
+						revision  = "HEAD"
+						recursive = true
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_tree.test", "recursive", "true"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.%", "8"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.services/first/src.type", "tree"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.services/first/src/main.go.type", "blob"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitTree_IncludeExclude(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFiles(t, worktree, "README.md", "services/first/main.tf", "services/first/src/main.go", "services/second/main.tf")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_tree" "test" {
+						directory = "%s"
+						revision  = "HEAD"
+						path      = "services"
+						recursive = true
+						include   = ["**/*.tf"]
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_tree.test", "include.#", "1"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.%", "2"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.services/first/main.tf.type", "blob"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.services/second/main.tf.type", "blob"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "git_tree" "test" {
+						directory = "%s"
+						revision  = "HEAD"
+						path      = "services"
+						recursive = true
+						include   = ["**/*.tf"]
+						exclude   = ["services/second/**"]
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_tree.test", "exclude.#", "1"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.%", "1"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.services/first/main.tf.type", "blob"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitTree_BareRepository(t *testing.T) {
+	t.Parallel()
+	_, repository := testutils.CreateRepository(t)
+	directory := testutils.CreateBareRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFiles(t, worktree, "README.md", "services/first/main.tf", "services/first/src/main.go", "services/second/main.tf")
+	testutils.CreateRemoteWithUrls(t, repository, "origin", []string{filepath.FromSlash(directory)})
+	testutils.GitPush(t, repository, "refs/heads/master:refs/heads/master")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_tree" "test" {
+						directory = "%s"
+						revision  = "master"
+						path      = "services/first"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.%", "2"),
+					resource.TestCheckResourceAttr("data.git_tree.test", "entries.services/first/main.tf.size", "12"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitTree_MissingPath(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFiles(t, worktree, "README.md", "services/first/main.tf", "services/first/src/main.go", "services/second/main.tf")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_tree" "test" {
+						directory = "%s"
+						revision  = "HEAD"
+						path      = "does-not-exist"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot read tree`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitTree_InvalidPath(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFiles(t, worktree, "README.md", "services/first/main.tf", "services/first/src/main.go", "services/second/main.tf")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_tree" "test" {
+						directory = "%s"
+						revision  = "HEAD"
+						path      = "../services"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Invalid tree path`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitTree_InvalidPattern(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFiles(t, worktree, "README.md", "services/first/main.tf", "services/first/src/main.go", "services/second/main.tf")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_tree" "test" {
+						directory = "%s"
+						revision  = "HEAD"
+						exclude   = ["["]
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot match file path`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitTree_InvalidRepository(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "git_tree" "test" {
+						directory = "/some/random/path"
+						revision  = "HEAD"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Cannot open repository`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitTree_MissingRepository(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "git_tree" "test" {
+						revision = "HEAD"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Missing required argument`),
+			},
+		},
+	})
+}
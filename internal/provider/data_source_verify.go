@@ -0,0 +1,216 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type VerifyDataSource struct{}
+
+var (
+	_ datasource.DataSource = (*VerifyDataSource)(nil)
+)
+
+type verifyDataSourceModel struct {
+	Directory          types.String `tfsdk:"directory"`
+	Id                 types.String `tfsdk:"id"`
+	Revision           types.String `tfsdk:"revision"`
+	PublicKeys         types.Set    `tfsdk:"public_keys"`
+	AllowedSignersFile types.String `tfsdk:"allowed_signers_file"`
+	FailOnUnverified   types.Bool   `tfsdk:"fail_on_unverified"`
+	Type               types.String `tfsdk:"type"`
+	SHA1               types.String `tfsdk:"sha1"`
+	Verified           types.Bool   `tfsdk:"verified"`
+	SignerKeyId        types.String `tfsdk:"signer_key_id"`
+	SignerIdentity     types.String `tfsdk:"signer_identity"`
+	Reason             types.String `tfsdk:"reason"`
+}
+
+func NewVerifyDataSource() datasource.DataSource {
+	return &VerifyDataSource{}
+}
+
+func (d *VerifyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_verify"
+}
+
+func (d *VerifyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Verifies the signature of a commit or tag similar to 'git verify-commit' and 'git verify-tag'.",
+		MarkdownDescription: "Verifies the signature of a commit or tag similar to `git verify-commit` and `git verify-tag`.",
+		Attributes: map[string]schema.Attribute{
+			"directory": schema.StringAttribute{
+				Description:         "The path to the local Git repository.",
+				MarkdownDescription: "The path to the local Git repository.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description:         "The same value as the 'revision' attribute.",
+				MarkdownDescription: "The same value as the `revision` attribute.",
+				Computed:            true,
+			},
+			"revision": schema.StringAttribute{
+				Description:         "The name of an annotated tag or the revision of a commit to verify. Note that 'go-git' does not support every revision type at the moment.",
+				MarkdownDescription: "The name of an annotated tag or the [revision](https://www.git-scm.com/docs/gitrevisions) of a commit to verify. Note that `go-git` does not [support](https://pkg.go.dev/github.com/go-git/go-git/v5#Repository.ResolveRevision) every revision type at the moment.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"public_keys": schema.SetAttribute{
+				Description:         "The armored OpenPGP public keys to verify OpenPGP signatures with.",
+				MarkdownDescription: "The armored OpenPGP public keys to verify OpenPGP signatures with.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+					setvalidator.AtLeastOneOf(path.MatchRoot("allowed_signers_file")),
+				},
+			},
+			"allowed_signers_file": schema.StringAttribute{
+				Description:         "The path to an allowed signers file similar to 'gpg.ssh.allowedSignersFile' to verify SSH signatures with.",
+				MarkdownDescription: "The path to an allowed signers file similar to `gpg.ssh.allowedSignersFile` to verify SSH signatures with.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"fail_on_unverified": schema.BoolAttribute{
+				Description:         "Whether to raise an error in case the signature cannot be verified. Defaults to 'false'.",
+				MarkdownDescription: "Whether to raise an error in case the signature cannot be verified. Defaults to `false`.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				Description:         "The type of the verified object. Either 'commit' or 'tag'.",
+				MarkdownDescription: "The type of the verified object. Either `commit` or `tag`.",
+				Computed:            true,
+			},
+			"sha1": schema.StringAttribute{
+				Description:         "The SHA1 hash of the verified object.",
+				MarkdownDescription: "The SHA1 hash of the verified object.",
+				Computed:            true,
+			},
+			"verified": schema.BoolAttribute{
+				Description:         "Whether the object carries a valid signature of one of the given keys.",
+				MarkdownDescription: "Whether the object carries a valid signature of one of the given keys.",
+				Computed:            true,
+			},
+			"signer_key_id": schema.StringAttribute{
+				Description:         "The ID of the OpenPGP key or the SHA256 fingerprint of the SSH key which created the signature.",
+				MarkdownDescription: "The ID of the OpenPGP key or the SHA256 fingerprint of the SSH key which created the signature.",
+				Computed:            true,
+			},
+			"signer_identity": schema.StringAttribute{
+				Description:         "The primary identity of the OpenPGP key or the principals of the allowed signer which created the signature.",
+				MarkdownDescription: "The primary identity of the OpenPGP key or the principals of the allowed signer which created the signature.",
+				Computed:            true,
+			},
+			"reason": schema.StringAttribute{
+				Description:         "The reason why the signature could not be verified.",
+				MarkdownDescription: "The reason why the signature could not be verified.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *VerifyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Read data source git_verify")
+
+	var inputs verifyDataSourceModel
+	diags := req.Config.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := inputs.Directory.ValueString()
+	revision := inputs.Revision.ValueString()
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	keyring := make(openpgp.EntityList, 0)
+	if !inputs.PublicKeys.IsNull() {
+		var publicKeys []string
+		resp.Diagnostics.Append(inputs.PublicKeys.ElementsAs(ctx, &publicKeys, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, publicKey := range publicKeys {
+			entities := readKeyring(publicKey, &resp.Diagnostics)
+			if entities == nil {
+				return
+			}
+			keyring = append(keyring, entities...)
+		}
+	}
+
+	var signers []allowedSigner
+	if !inputs.AllowedSignersFile.IsNull() {
+		signers = readAllowedSigners(ctx, inputs.AllowedSignersFile.ValueString(), &resp.Diagnostics)
+		if signers == nil {
+			return
+		}
+	}
+
+	signed := getSignedObject(ctx, repository, revision, &resp.Diagnostics)
+	if signed == nil {
+		return
+	}
+
+	result := verifySignedObject(ctx, signed, keyring, signers)
+	if !result.verified && inputs.FailOnUnverified.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Cannot verify signature",
+			"Could not verify signature of "+signed.kind+" ["+signed.hash.String()+"] in repository ["+directory+"] because of: "+result.reason,
+		)
+		return
+	}
+
+	var state verifyDataSourceModel
+	state.Directory = inputs.Directory
+	state.Id = inputs.Revision
+	state.Revision = inputs.Revision
+	state.PublicKeys = inputs.PublicKeys
+	state.AllowedSignersFile = inputs.AllowedSignersFile
+	state.FailOnUnverified = inputs.FailOnUnverified
+	state.Type = types.StringValue(signed.kind)
+	state.SHA1 = types.StringValue(signed.hash.String())
+	state.Verified = types.BoolValue(result.verified)
+	if result.verified {
+		state.SignerKeyId = types.StringValue(result.keyID)
+		state.SignerIdentity = types.StringValue(result.identity)
+		state.Reason = types.StringNull()
+	} else {
+		state.SignerKeyId = types.StringNull()
+		state.SignerIdentity = types.StringNull()
+		state.Reason = types.StringValue(result.reason)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
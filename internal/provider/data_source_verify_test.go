@@ -0,0 +1,298 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/metio/terraform-provider-git/internal/testutils"
+)
+
+func TestDataSourceGitVerify_Commit_OpenPGP(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	fileName := "some-file"
+	testutils.WriteFileInWorktree(t, worktree, fileName)
+	testutils.GitAdd(t, worktree, fileName)
+	entity, _, publicKey := testutils.OpenPGPKey(t, "")
+	_, _, otherPublicKey := testutils.OpenPGPKey(t, "")
+	commit := testutils.GitCommitWith(t, worktree, &git.CommitOptions{
+		Author:  testutils.Signature(),
+		SignKey: entity,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_verify" "signer" {
+						directory   = "%s"
+						revision    = "HEAD"
+						public_keys = [
+							<<EOT
+%sEOT
+							,
+							<<EOT
+%sEOT
+						]
+					}
+
+					data "git_verify" "other" {
+						directory   = "%s"
+						revision    = "HEAD"
+						public_keys = [
+							<<EOT
+%sEOT
+						]
+					}
+				`, directory, otherPublicKey, publicKey, directory, otherPublicKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_verify.signer", "directory", directory),
+					resource.TestCheckResourceAttr("data.git_verify.signer", "id", "HEAD"),
+					resource.TestCheckResourceAttr("data.git_verify.signer", "revision", "HEAD"),
+					resource.TestCheckResourceAttr("data.git_verify.signer", "type", "commit"),
+					resource.TestCheckResourceAttr("data.git_verify.signer", "sha1", commit.String()),
+					resource.TestCheckResourceAttr("data.git_verify.signer", "verified", "true"),
+					resource.TestCheckResourceAttr("data.git_verify.signer", "signer_key_id", entity.PrimaryKey.KeyIdString()),
+					resource.TestCheckResourceAttr("data.git_verify.signer", "signer_identity", "Some Person <person@example.com>"),
+					resource.TestCheckNoResourceAttr("data.git_verify.signer", "reason"),
+					resource.TestCheckResourceAttr("data.git_verify.other", "verified", "false"),
+					resource.TestCheckNoResourceAttr("data.git_verify.other", "signer_key_id"),
+					resource.TestCheckNoResourceAttr("data.git_verify.other", "signer_identity"),
+					resource.TestCheckResourceAttrWith("data.git_verify.other", "reason", testutils.CheckMinLength(1)),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitVerify_Commit_SSH(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	name := "some-file"
+	testutils.WriteFileInWorktree(t, worktree, name)
+	testutils.GitAdd(t, worktree, name)
+	privateKey, publicKey := testutils.SSHKey(t)
+	_, otherPublicKey := testutils.SSHKey(t)
+	allowedSigners := testutils.AllowedSignersFile(t, "person@example.com", publicKey)
+	otherAllowedSigners := testutils.AllowedSignersFile(t, "other@example.com", otherPublicKey)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_commit" "test" {
+						directory = "%s"
+						message   = "committed with terraform"
+						signing   = {
+							format          = "ssh"
+							private_key_pem = <<EOT
+%sEOT
+						}
+					}
+
+					data "git_verify" "signer" {
+						directory            = git_commit.test.directory
+						revision             = git_commit.test.sha1
+						allowed_signers_file = "%s"
+					}
+
+					data "git_verify" "other" {
+						directory            = git_commit.test.directory
+						revision             = git_commit.test.sha1
+						allowed_signers_file = "%s"
+					}
+				`, directory, privateKey, allowedSigners, otherAllowedSigners),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_verify.signer", "type", "commit"),
+					resource.TestCheckResourceAttr("data.git_verify.signer", "verified", "true"),
+					resource.TestMatchResourceAttr("data.git_verify.signer", "signer_key_id", regexp.MustCompile(`^SHA256:`)),
+					resource.TestCheckResourceAttr("data.git_verify.signer", "signer_identity", "person@example.com"),
+					resource.TestCheckResourceAttr("data.git_verify.other", "verified", "false"),
+					resource.TestMatchResourceAttr("data.git_verify.other", "reason", regexp.MustCompile(`no allowed signer`)),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitVerify_Tag(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	tag := "some-tag"
+	entity, _, publicKey := testutils.OpenPGPKey(t, "")
+	testutils.CreateTagWith(t, repository, tag, &git.CreateTagOptions{
+		Message: tag,
+		Tagger:  testutils.Signature(),
+		SignKey: entity,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_verify" "tag" {
+						directory   = "%s"
+						revision    = "%s"
+						public_keys = [
+							<<EOT
+%sEOT
+						]
+					}
+
+					data "git_verify" "commit" {
+						directory   = "%s"
+						revision    = "%s^{commit}"
+						public_keys = [
+							<<EOT
+%sEOT
+						]
+					}
+				`, directory, tag, publicKey, directory, tag, publicKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_verify.tag", "type", "tag"),
+					resource.TestCheckResourceAttrWith("data.git_verify.tag", "sha1", testutils.CheckExactLength(40)),
+					resource.TestCheckResourceAttr("data.git_verify.tag", "verified", "true"),
+					resource.TestCheckResourceAttr("data.git_verify.tag", "signer_key_id", entity.PrimaryKey.KeyIdString()),
+					resource.TestCheckResourceAttr("data.git_verify.commit", "type", "commit"),
+					resource.TestCheckResourceAttr("data.git_verify.commit", "verified", "false"),
+					resource.TestCheckResourceAttr("data.git_verify.commit", "reason", "object is not signed"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitVerify_FailOnUnverified(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	_, _, publicKey := testutils.OpenPGPKey(t, "")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_verify" "test" {
+						directory          = "%s"
+						revision           = "HEAD"
+						fail_on_unverified = true
+						public_keys        = [
+							<<EOT
+%sEOT
+						]
+					}
+				`, directory, publicKey),
+				ExpectError: regexp.MustCompile(`Cannot verify signature`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitVerify_InvalidPublicKey(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_verify" "test" {
+						directory   = "%s"
+						revision    = "HEAD"
+						public_keys = ["not a key"]
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Invalid keyring`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitVerify_InvalidAllowedSigners(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	allowedSigners := testutils.AllowedSignersFile(t, "person@example.com", "not a key")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_verify" "test" {
+						directory            = "%s"
+						revision             = "HEAD"
+						allowed_signers_file = "%s"
+					}
+				`, directory, allowedSigners),
+				ExpectError: regexp.MustCompile(`Invalid allowed signers`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitVerify_MissingKeys(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_verify" "test" {
+						directory = "%s"
+						revision  = "HEAD"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Missing Attribute Configuration`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitVerify_InvalidRepository(t *testing.T) {
+	t.Parallel()
+	directory := t.TempDir()
+	allowedSigners := testutils.AllowedSignersFile(t, "person@example.com", "not a key")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_verify" "test" {
+						directory            = "%s"
+						revision             = "HEAD"
+						allowed_signers_file = "%s"
+					}
+				`, directory, allowedSigners),
+				ExpectError: regexp.MustCompile(`Cannot open repository`),
+			},
+		},
+	})
+}
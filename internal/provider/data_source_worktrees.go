@@ -0,0 +1,191 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type WorktreesDataSource struct{}
+
+var (
+	_ datasource.DataSource = (*WorktreesDataSource)(nil)
+)
+
+type worktreesDataSourceModel struct {
+	Directory types.String `tfsdk:"directory"`
+	Id        types.String `tfsdk:"id"`
+	Worktrees types.Map    `tfsdk:"worktrees"`
+}
+
+func NewWorktreesDataSource() datasource.DataSource {
+	return &WorktreesDataSource{}
+}
+
+func (d *WorktreesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_worktrees"
+}
+
+func (d *WorktreesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Fetches all worktrees of a Git repository similar to 'git worktree list'.",
+		MarkdownDescription: "Fetches all worktrees of a Git repository similar to `git worktree list`.",
+		Attributes: map[string]schema.Attribute{
+			"directory": schema.StringAttribute{
+				Description:         "The path to the local Git repository. Can be the main worktree or any linked worktree.",
+				MarkdownDescription: "The path to the local Git repository. Can be the main worktree or any linked worktree.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description:         "The same value as the 'directory' attribute.",
+				MarkdownDescription: "The same value as the `directory` attribute.",
+				Computed:            true,
+			},
+			"worktrees": schema.MapNestedAttribute{
+				Description:         "All worktrees of a Git repository keyed by their path. The main worktree is always included.",
+				MarkdownDescription: "All worktrees of a Git repository keyed by their path. The main worktree is always included.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description:         "The name of the administrative directory of a linked worktree. Empty for the main worktree.",
+							MarkdownDescription: "The name of the administrative directory of a linked worktree. Empty for the main worktree.",
+							Computed:            true,
+						},
+						"branch": schema.StringAttribute{
+							Description:         "The branch checked out in the worktree. Not set in case the worktree is in detached mode.",
+							MarkdownDescription: "The branch checked out in the worktree. Not set in case the worktree is in detached mode.",
+							Computed:            true,
+						},
+						"sha1": schema.StringAttribute{
+							Description:         "The SHA1 hash of the commit checked out in the worktree. Not set in case the checked out branch has no commits yet.",
+							MarkdownDescription: "The SHA1 hash of the commit checked out in the worktree. Not set in case the checked out branch has no commits yet.",
+							Computed:            true,
+						},
+						"bare": schema.BoolAttribute{
+							Description:         "Whether the worktree is the directory of a bare repository.",
+							MarkdownDescription: "Whether the worktree is the directory of a bare repository.",
+							Computed:            true,
+						},
+						"detached": schema.BoolAttribute{
+							Description:         "Whether 'HEAD' of the worktree points to a commit rather than a branch.",
+							MarkdownDescription: "Whether `HEAD` of the worktree points to a commit rather than a branch.",
+							Computed:            true,
+						},
+						"locked": schema.BoolAttribute{
+							Description:         "Whether the worktree is locked.",
+							MarkdownDescription: "Whether the worktree is locked.",
+							Computed:            true,
+						},
+						"lock_reason": schema.StringAttribute{
+							Description:         "The reason why the worktree is locked. Not set in case no reason was given.",
+							MarkdownDescription: "The reason why the worktree is locked. Not set in case no reason was given.",
+							Computed:            true,
+						},
+						"prunable": schema.BoolAttribute{
+							Description:         "Whether the directory of the worktree is missing and its administrative files can be pruned.",
+							MarkdownDescription: "Whether the directory of the worktree is missing and its administrative files can be pruned.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WorktreesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Read data source git_worktrees")
+
+	var inputs worktreesDataSourceModel
+	var state worktreesDataSourceModel
+
+	diags := req.Config.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := inputs.Directory.ValueString()
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	worktrees := listWorktrees(ctx, repository, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	worktreeType := map[string]attr.Type{
+		"name":        types.StringType,
+		"branch":      types.StringType,
+		"sha1":        types.StringType,
+		"bare":        types.BoolType,
+		"detached":    types.BoolType,
+		"locked":      types.BoolType,
+		"lock_reason": types.StringType,
+		"prunable":    types.BoolType,
+	}
+
+	allWorktrees := make(map[string]attr.Value)
+	for _, worktree := range worktrees {
+		detached := worktree.head.Type() == plumbing.HashReference
+		branch := types.StringNull()
+		if !detached {
+			branch = types.StringValue(worktree.head.Target().Short())
+		}
+		sha1 := types.StringNull()
+		if hash := resolveWorktreeHead(repository, worktree.head); hash != nil {
+			sha1 = types.StringValue(hash.String())
+		}
+		lockReason := types.StringNull()
+		if worktree.lockReason != "" {
+			lockReason = types.StringValue(worktree.lockReason)
+		}
+		allWorktrees[worktree.path] = types.ObjectValueMust(
+			worktreeType,
+			map[string]attr.Value{
+				"name":        types.StringValue(worktree.name),
+				"branch":      branch,
+				"sha1":        sha1,
+				"bare":        types.BoolValue(worktree.bare),
+				"detached":    types.BoolValue(detached),
+				"locked":      types.BoolValue(worktree.locked),
+				"lock_reason": lockReason,
+				"prunable":    types.BoolValue(worktree.prunable),
+			},
+		)
+	}
+
+	state.Directory = inputs.Directory
+	state.Id = inputs.Directory
+	state.Worktrees = types.MapValueMust(
+		types.ObjectType{
+			AttrTypes: worktreeType,
+		},
+		allWorktrees,
+	)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,152 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/metio/terraform-provider-git/internal/testutils"
+)
+
+func TestDataSourceGitWorktrees(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	head := testutils.GetRepositoryHead(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_worktrees" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_worktrees.test", "directory", directory),
+					resource.TestCheckResourceAttr("data.git_worktrees.test", "id", directory),
+					resource.TestCheckResourceAttr("data.git_worktrees.test", "worktrees.%", "1"),
+					resource.TestCheckResourceAttr("data.git_worktrees.test", fmt.Sprintf("worktrees.%s.name", directory), ""),
+					resource.TestCheckResourceAttr("data.git_worktrees.test", fmt.Sprintf("worktrees.%s.branch", directory), "master"),
+					resource.TestCheckResourceAttr("data.git_worktrees.test", fmt.Sprintf("worktrees.%s.sha1", directory), head.Hash().String()),
+					resource.TestCheckResourceAttr("data.git_worktrees.test", fmt.Sprintf("worktrees.%s.bare", directory), "false"),
+					resource.TestCheckResourceAttr("data.git_worktrees.test", fmt.Sprintf("worktrees.%s.detached", directory), "false"),
+					resource.TestCheckResourceAttr("data.git_worktrees.test", fmt.Sprintf("worktrees.%s.locked", directory), "false"),
+					resource.TestCheckNoResourceAttr("data.git_worktrees.test", fmt.Sprintf("worktrees.%s.lock_reason", directory)),
+					resource.TestCheckResourceAttr("data.git_worktrees.test", fmt.Sprintf("worktrees.%s.prunable", directory), "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitWorktrees_Linked(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	head := testutils.GetRepositoryHead(t, repository)
+	path := filepath.Join(t.TempDir(), "linked")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_worktree" "test" {
+						directory   = "%s"
+						path        = "%s"
+						locked      = true
+						lock_reason = "testing"
+					}
+					data "git_worktrees" "test" {
+						directory = git_worktree.test.path
+					}
+				`, directory, path),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_worktrees.test", "worktrees.%", "2"),
+					resource.TestCheckResourceAttr("data.git_worktrees.test", fmt.Sprintf("worktrees.%s.branch", directory), "master"),
+					resource.TestCheckResourceAttr("data.git_worktrees.test", fmt.Sprintf("worktrees.%s.name", path), "linked"),
+					resource.TestCheckNoResourceAttr("data.git_worktrees.test", fmt.Sprintf("worktrees.%s.branch", path)),
+					resource.TestCheckResourceAttr("data.git_worktrees.test", fmt.Sprintf("worktrees.%s.sha1", path), head.Hash().String()),
+					resource.TestCheckResourceAttr("data.git_worktrees.test", fmt.Sprintf("worktrees.%s.detached", path), "true"),
+					resource.TestCheckResourceAttr("data.git_worktrees.test", fmt.Sprintf("worktrees.%s.locked", path), "true"),
+					resource.TestCheckResourceAttr("data.git_worktrees.test", fmt.Sprintf("worktrees.%s.lock_reason", path), "testing"),
+					resource.TestCheckResourceAttr("data.git_worktrees.test", fmt.Sprintf("worktrees.%s.prunable", path), "false"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_worktree" "test" {
+						directory = "%s"
+						path      = "%s"
+					}
+				`, directory, path),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitWorktrees_BareRepository(t *testing.T) {
+	t.Parallel()
+	directory := testutils.CreateBareRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "git_worktrees" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.git_worktrees.test", "worktrees.%", "1"),
+					resource.TestCheckResourceAttr("data.git_worktrees.test", fmt.Sprintf("worktrees.%s.bare", directory), "true"),
+					resource.TestCheckNoResourceAttr("data.git_worktrees.test", fmt.Sprintf("worktrees.%s.sha1", directory)),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitWorktrees_InvalidRepository(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "git_worktrees" "test" {
+						directory = "/some/random/path"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Cannot open repository`),
+			},
+		},
+	})
+}
+
+func TestDataSourceGitWorktrees_MissingRepository(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "git_worktrees" "test" {}
+				`,
+				ExpectError: regexp.MustCompile(`Missing required argument`),
+			},
+		},
+	})
+}
@@ -16,7 +16,11 @@ import (
 	ssh2 "golang.org/x/crypto/ssh"
 )
 
-func authOptions(ctx context.Context, auth types.Object, diag *diag.Diagnostics) transport.AuthMethod {
+func authOptions(ctx context.Context, auth types.Object, defaults *GitProviderModel, diag *diag.Diagnostics) transport.AuthMethod {
+	if (auth.IsNull() || auth.IsUnknown()) && defaults != nil {
+		auth = defaults.Auth
+	}
+
 	basicAuth, basicOk := auth.Attributes()["basic"].(types.Object)
 	bearerAuth, bearerOk := auth.Attributes()["bearer"].(types.String)
 	sshKeyAuth, sshKeyOk := auth.Attributes()["ssh_key"].(types.Object)
@@ -38,14 +42,19 @@ func authOptions(ctx context.Context, auth types.Object, diag *diag.Diagnostics)
 	} else if sshKeyOk && !sshKeyAuth.IsNull() {
 		username := sshKeyAuth.Attributes()["username"].(types.String)
 		password := sshKeyAuth.Attributes()["password"].(types.String)
+		if username.IsNull() {
+			// the provider level configuration does not use plan modifiers to set defaults
+			username = types.StringValue("git")
+		}
+
+		keyPath, keyPathOk := sshKeyAuth.Attributes()["private_key_path"].(types.String)
+		keyPem, keyPemOk := sshKeyAuth.Attributes()["private_key_pem"].(types.String)
 
 		var sshKeys *ssh.PublicKeys
 		var err error
-		if sshKeyAuth.Attributes()["private_key_path"] != nil {
-			keyPath := sshKeyAuth.Attributes()["private_key_path"].(types.String)
+		if keyPathOk && !keyPath.IsNull() {
 			sshKeys, err = ssh.NewPublicKeysFromFile(username.ValueString(), keyPath.ValueString(), password.ValueString())
-		} else if sshKeyAuth.Attributes()["private_key_pem"] != nil {
-			keyPem := sshKeyAuth.Attributes()["private_key_pem"].(types.String)
+		} else if keyPemOk && !keyPem.IsNull() {
 			sshKeys, err = ssh.NewPublicKeys(username.ValueString(), []byte(keyPem.ValueString()), password.ValueString())
 		} else {
 			diag.AddError(
@@ -0,0 +1,152 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func getBranchReference(ctx context.Context, repository *git.Repository, branchName string, diag *diag.Diagnostics) (*plumbing.Reference, error) {
+	reference, err := repository.Reference(plumbing.NewBranchReferenceName(branchName), false)
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		tflog.Trace(ctx, "branch does not exist", map[string]interface{}{
+			"branch": branchName,
+		})
+		return nil, nil
+	} else if err != nil {
+		diag.AddError(
+			"Cannot read branch",
+			"Could not read branch ["+branchName+"] because of: "+err.Error(),
+		)
+		return nil, err
+	}
+	tflog.Trace(ctx, "read branch", map[string]interface{}{
+		"branch": branchName,
+		"hash":   reference.Hash().String(),
+	})
+	return reference, nil
+}
+
+func setBranchReference(ctx context.Context, repository *git.Repository, branchName string, hash plumbing.Hash, force bool, diag *diag.Diagnostics) {
+	current, err := getBranchReference(ctx, repository, branchName, diag)
+	if err != nil {
+		return
+	}
+
+	if current != nil && current.Hash() != hash && !force {
+		currentHash := current.Hash()
+		currentCommit := getCommit(ctx, repository, &currentHash, diag)
+		if currentCommit == nil {
+			return
+		}
+		targetCommit := getCommit(ctx, repository, &hash, diag)
+		if targetCommit == nil {
+			return
+		}
+		isAncestor, errAncestor := currentCommit.IsAncestor(targetCommit)
+		if errAncestor != nil {
+			diag.AddError(
+				"Cannot move branch",
+				"Could not compare commits of branch ["+branchName+"] because of: "+errAncestor.Error(),
+			)
+			return
+		}
+		if !isAncestor {
+			diag.AddError(
+				"Cannot move branch",
+				"Could not move branch ["+branchName+"] from ["+current.Hash().String()+"] to ["+hash.String()+"] because it is not a fast-forward. Set 'force' to move the branch anyway.",
+			)
+			return
+		}
+	}
+
+	err = repository.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName(branchName), hash))
+	if err != nil {
+		diag.AddError(
+			"Cannot update branch",
+			"Could not update branch ["+branchName+"] because of: "+err.Error(),
+		)
+		return
+	}
+	tflog.Trace(ctx, "updated branch", map[string]interface{}{
+		"branch": branchName,
+		"hash":   hash.String(),
+	})
+}
+
+func setBranchConfig(ctx context.Context, repository *git.Repository, branchName string, remote types.String, merge types.String, rebase types.String, diag *diag.Diagnostics) {
+	cfg, err := repository.Config()
+	if err != nil {
+		diag.AddError(
+			"Error reading config",
+			"Could not read git config because of: "+err.Error(),
+		)
+		return
+	}
+
+	if remote.IsNull() && merge.IsNull() && rebase.IsNull() {
+		if _, ok := cfg.Branches[branchName]; !ok {
+			return
+		}
+		delete(cfg.Branches, branchName)
+	} else {
+		branch, ok := cfg.Branches[branchName]
+		if !ok {
+			branch = &config.Branch{Name: branchName}
+		}
+		branch.Remote = remote.ValueString()
+		branch.Merge = ""
+		if !merge.IsNull() {
+			branch.Merge = expandBranchReferenceName(merge.ValueString())
+		}
+		branch.Rebase = rebase.ValueString()
+		if err = branch.Validate(); err != nil {
+			diag.AddError(
+				"Invalid branch configuration",
+				"Could not configure branch ["+branchName+"] because of: "+err.Error(),
+			)
+			return
+		}
+		cfg.Branches[branchName] = branch
+	}
+
+	err = repository.SetConfig(cfg)
+	if err != nil {
+		diag.AddError(
+			"Error writing config",
+			"Could not write git config because of: "+err.Error(),
+		)
+		return
+	}
+	tflog.Trace(ctx, "wrote branch config", map[string]interface{}{
+		"branch": branchName,
+	})
+}
+
+func readBranchConfig(ctx context.Context, repository *git.Repository, branchName string, diag *diag.Diagnostics) *config.Branch {
+	branch, err := repository.Branch(branchName)
+	if errors.Is(err, git.ErrBranchNotFound) {
+		return nil
+	} else if err != nil {
+		diag.AddError(
+			"Cannot read branch",
+			"Could not read configuration of branch ["+branchName+"] because of: "+err.Error(),
+		)
+		return nil
+	}
+	tflog.Trace(ctx, "read branch config", map[string]interface{}{
+		"branch": branchName,
+	})
+	return branch
+}
@@ -0,0 +1,167 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func createCheckoutOptions(ctx context.Context, repository *git.Repository, inputs checkoutResourceModel, diag *diag.Diagnostics) *git.CheckoutOptions {
+	options := &git.CheckoutOptions{}
+
+	if inputs.Force.ValueBool() && inputs.Keep.ValueBool() {
+		diag.AddError(
+			"Invalid checkout options",
+			"The 'force' and 'keep' options are mutually exclusive. Set only one of them to 'true'.",
+		)
+		return nil
+	}
+
+	if len(inputs.Branch.ValueString()) > 0 {
+		options.Branch = expandBranchReferenceName(inputs.Branch.ValueString())
+		tflog.Trace(ctx, "using 'Branch'", map[string]interface{}{
+			"Branch": options.Branch.String(),
+		})
+	}
+
+	if len(inputs.Hash.ValueString()) > 0 {
+		hash := resolveRevision(ctx, repository, inputs.Hash.ValueString(), diag)
+		if hash == nil {
+			return nil
+		}
+		options.Hash = *hash
+		tflog.Trace(ctx, "using 'Hash'", map[string]interface{}{
+			"Hash": hash.String(),
+		})
+	}
+
+	options.Create = inputs.Create.ValueBool()
+	tflog.Trace(ctx, "using 'Create'", map[string]interface{}{
+		"Create": inputs.Create.ValueBool(),
+	})
+
+	options.Force = inputs.Force.ValueBool()
+	tflog.Trace(ctx, "using 'Force'", map[string]interface{}{
+		"Force": inputs.Force.ValueBool(),
+	})
+
+	options.Keep = inputs.Keep.ValueBool()
+	tflog.Trace(ctx, "using 'Keep'", map[string]interface{}{
+		"Keep": inputs.Keep.ValueBool(),
+	})
+
+	return options
+}
+
+// checkoutBranch returns the local branch HEAD points to after checking out the given inputs or an empty name in case
+// HEAD is detached. Checking out neither a branch nor a hash switches to 'master' just like go-git does.
+func checkoutBranch(inputs checkoutResourceModel) plumbing.ReferenceName {
+	if len(inputs.Branch.ValueString()) > 0 {
+		branch := expandBranchReferenceName(inputs.Branch.ValueString())
+		if branch.IsBranch() || inputs.Create.ValueBool() {
+			return branch
+		}
+		return ""
+	}
+	if len(inputs.Hash.ValueString()) > 0 {
+		return ""
+	}
+	return plumbing.Master
+}
+
+// headMatchesCheckout returns true if HEAD still points to what was checked out. Branches are compared by name since
+// their tip moves with new commits. Detached checkouts are compared with the commit stored in the state, since
+// revisions like 'HEAD~1' resolve to a different commit each time.
+func headMatchesCheckout(ctx context.Context, repository *git.Repository, state checkoutResourceModel, diag *diag.Diagnostics) bool {
+	head, err := repository.Reference(plumbing.HEAD, false)
+	if err != nil {
+		diag.AddError(
+			"Cannot read HEAD",
+			"Could not read HEAD because of: "+err.Error(),
+		)
+		return false
+	}
+
+	if branch := checkoutBranch(state); branch != "" {
+		matches := head.Type() == plumbing.SymbolicReference && head.Target() == branch
+		tflog.Trace(ctx, "compared HEAD with branch", map[string]interface{}{
+			"branch":  branch.String(),
+			"matches": matches,
+		})
+		return matches
+	}
+
+	matches := head.Type() == plumbing.HashReference && head.Hash().String() == state.SHA1.ValueString()
+	tflog.Trace(ctx, "compared HEAD with commit", map[string]interface{}{
+		"hash":    state.SHA1.ValueString(),
+		"matches": matches,
+	})
+	return matches
+}
+
+// resetExistingBranch checks out an already existing branch and resets it to the start point of the checkout similar
+// to 'git checkout -B'. This happens when a checkout that created the branch is replaced.
+func resetExistingBranch(ctx context.Context, repository *git.Repository, worktree *git.Worktree, options *git.CheckoutOptions, diag *diag.Diagnostics) bool {
+	start := options.Hash
+	if start.IsZero() {
+		head, err := repository.Head()
+		if err != nil {
+			diag.AddError(
+				"Cannot read HEAD",
+				"Could not read HEAD because of: "+err.Error(),
+			)
+			return false
+		}
+		start = head.Hash()
+	}
+
+	// moving to the start point first updates the worktree just like any other checkout would
+	err := worktree.Checkout(&git.CheckoutOptions{
+		Hash:  start,
+		Force: options.Force,
+		Keep:  options.Keep,
+	})
+	if err != nil {
+		diag.AddError(
+			"Cannot checkout",
+			"Could not checkout ["+start.String()+"] because of: "+err.Error(),
+		)
+		return false
+	}
+
+	err = repository.Storer.SetReference(plumbing.NewHashReference(options.Branch, start))
+	if err != nil {
+		diag.AddError(
+			"Cannot reset branch",
+			"Could not reset branch ["+options.Branch.String()+"] to ["+start.String()+"] because of: "+err.Error(),
+		)
+		return false
+	}
+
+	// the branch already points at the checked out commit, thus the worktree is kept as is
+	err = worktree.Checkout(&git.CheckoutOptions{
+		Branch: options.Branch,
+		Keep:   true,
+	})
+	if err != nil {
+		diag.AddError(
+			"Cannot checkout",
+			"Could not checkout existing branch ["+options.Branch.String()+"] because of: "+err.Error(),
+		)
+		return false
+	}
+
+	tflog.Trace(ctx, "reset existing branch", map[string]interface{}{
+		"branch": options.Branch.String(),
+		"start":  start.String(),
+	})
+	return true
+}
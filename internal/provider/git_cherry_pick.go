@@ -0,0 +1,287 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// commitPick describes the changes of a single commit which are applied onto the current branch. The changes are
+// the difference between 'base' and 'target', thus reverting a commit swaps both trees.
+type commitPick struct {
+	commit  *object.Commit
+	base    *object.Tree
+	target  *object.Tree
+	message string
+	author  *object.Signature
+}
+
+// getCommitPicks resolves the given revisions into the commits to cherry-pick or revert in order.
+func getCommitPicks(ctx context.Context, repository *git.Repository, revisions types.List, revert bool, diag *diag.Diagnostics) []*commitPick {
+	var names []string
+	diag.Append(revisions.ElementsAs(ctx, &names, false)...)
+	if diag.HasError() {
+		return nil
+	}
+
+	picks := make([]*commitPick, 0, len(names))
+	for _, name := range names {
+		hash := resolveRevision(ctx, repository, name, diag)
+		if hash == nil {
+			return nil
+		}
+		commit := getCommit(ctx, repository, hash, diag)
+		if commit == nil {
+			return nil
+		}
+		if commit.NumParents() > 1 {
+			diag.AddError(
+				"Cannot pick merge commit",
+				"The revision ["+name+"] points to the merge commit ["+commit.Hash.String()+"] which has more than one parent. Specify the commits of the merged branch instead.",
+			)
+			return nil
+		}
+
+		// the root commit is compared against the empty tree
+		parentTree := &object.Tree{}
+		if commit.NumParents() == 1 {
+			parent, err := commit.Parent(0)
+			if err != nil {
+				diag.AddError(
+					"Cannot read commit",
+					"Could not read parent of commit ["+commit.Hash.String()+"] because of: "+err.Error(),
+				)
+				return nil
+			}
+			parentTree = getTree(ctx, parent, diag)
+			if parentTree == nil {
+				return nil
+			}
+		}
+		tree := getTree(ctx, commit, diag)
+		if tree == nil {
+			return nil
+		}
+
+		pick := &commitPick{commit: commit}
+		if revert {
+			pick.base = tree
+			pick.target = parentTree
+			pick.message = revertMessage(commit)
+		} else {
+			pick.base = parentTree
+			pick.target = tree
+			pick.message = commit.Message
+			pick.author = &commit.Author
+		}
+		picks = append(picks, pick)
+	}
+
+	tflog.Trace(ctx, "resolved commits", map[string]interface{}{
+		"revisions": names,
+		"revert":    revert,
+	})
+	return picks
+}
+
+func revertMessage(commit *object.Commit) string {
+	subject, _, _ := strings.Cut(commit.Message, "\n")
+	return "Revert \"" + subject + "\"\n\nThis reverts commit " + commit.Hash.String() + ".\n"
+}
+
+// pickTrees calculates the changes of each pick on top of 'head' and the changes of all previous picks. A file is
+// reported as conflict if its current content differs from the content the pick expects to change. The worktree is
+// not modified, thus conflicts are found before anything is committed.
+func pickTrees(ctx context.Context, head *object.Tree, picks []*commitPick, diag *diag.Diagnostics) ([]*treeMerge, *commitPick) {
+	current := make(map[string]object.TreeEntry)
+	merges := make([]*treeMerge, 0, len(picks))
+	for _, pick := range picks {
+		changes, err := object.DiffTreeWithOptions(ctx, pick.base, pick.target, nil)
+		if err != nil {
+			diag.AddError(
+				"Cannot compare trees",
+				"Could not compare the changes of commit ["+pick.commit.Hash.String()+"] because of: "+err.Error(),
+			)
+			return nil, nil
+		}
+
+		merge := &treeMerge{
+			changes:   make([]mergeChange, 0),
+			conflicts: make([]string, 0),
+		}
+		for _, change := range changes {
+			name := changePath(change)
+			entry := change.To.TreeEntry
+			existing, ok := current[name]
+			if !ok {
+				existing = findTreeEntry(head, name)
+			}
+			if sameTreeEntry(existing, entry) {
+				// the change is already part of the current branch
+				continue
+			}
+			if !sameTreeEntry(existing, change.From.TreeEntry) || entry.Mode == filemode.Submodule {
+				merge.conflicts = append(merge.conflicts, name)
+				continue
+			}
+			current[name] = entry
+			merge.changes = append(merge.changes, mergeChange{
+				path:  name,
+				entry: entry,
+				tree:  pick.target,
+			})
+		}
+		sort.Strings(merge.conflicts)
+
+		tflog.Trace(ctx, "picked tree", map[string]interface{}{
+			"commit":    pick.commit.Hash.String(),
+			"changes":   len(merge.changes),
+			"conflicts": merge.conflicts,
+		})
+		if len(merge.conflicts) > 0 {
+			return []*treeMerge{merge}, pick
+		}
+		merges = append(merges, merge)
+	}
+	return merges, nil
+}
+
+func findTreeEntry(tree *object.Tree, name string) object.TreeEntry {
+	entry, err := tree.FindEntry(name)
+	if err != nil {
+		return object.TreeEntry{}
+	}
+	return *entry
+}
+
+func sameTreeEntry(a object.TreeEntry, b object.TreeEntry) bool {
+	return a.Hash == b.Hash && (a.Hash.IsZero() || a.Mode == b.Mode)
+}
+
+// commitPicks applies the changes of each pick onto the worktree and records them in a new commit. The author of a
+// pick is preserved unless the given options already contain one. In case any pick fails, the current branch and all
+// changed files are reset to 'head', thus either all or none of the picks are committed.
+func commitPicks(ctx context.Context, worktree *git.Worktree, head plumbing.Hash, picks []*commitPick, merges []*treeMerge, options *git.CommitOptions, diag *diag.Diagnostics) []string {
+	hashes := make([]string, 0, len(picks))
+	for i, pick := range picks {
+		if !applyTreeMerge(ctx, worktree, merges[i], diag) {
+			resetTreeMerges(ctx, worktree, head, merges, diag)
+			return nil
+		}
+
+		commitOptions := *options
+		if commitOptions.Author == nil && pick.author != nil {
+			author := *pick.author
+			commitOptions.Author = &author
+		}
+
+		hash := createCommit(worktree, pick.message, &commitOptions, diag)
+		if hash == nil {
+			resetTreeMerges(ctx, worktree, head, merges, diag)
+			return nil
+		}
+		hashes = append(hashes, hash.String())
+
+		tflog.Trace(ctx, "picked commit", map[string]interface{}{
+			"commit": pick.commit.Hash.String(),
+			"sha1":   hash.String(),
+		})
+	}
+	return hashes
+}
+
+// pickCommits applies the given picks onto the currently checked out branch and returns the SHA1 hashes of the
+// created commits. Nothing is changed in case any of the picks conflicts with the current branch or does not change
+// anything because it is already applied. Similar to 'git cherry-pick' without '--allow-empty', picks that would
+// create empty commits are rejected rather than skipped, thus every revision results in exactly one commit.
+func pickCommits(ctx context.Context, repository *git.Repository, worktree *git.Worktree, picks []*commitPick, options *git.CommitOptions, action string, diag *diag.Diagnostics) []string {
+	head, err := repository.Head()
+	if err != nil {
+		diag.AddError(
+			"Cannot read HEAD",
+			"Could not read HEAD because of: "+err.Error(),
+		)
+		return nil
+	}
+	headHash := head.Hash()
+	headCommit := getCommit(ctx, repository, &headHash, diag)
+	if headCommit == nil {
+		return nil
+	}
+	headTree := getTree(ctx, headCommit, diag)
+	if headTree == nil {
+		return nil
+	}
+
+	merges, conflicting := pickTrees(ctx, headTree, picks, diag)
+	if merges == nil {
+		return nil
+	}
+	if conflicting != nil {
+		diag.AddError(
+			"Cannot "+action+" conflicting changes",
+			"Could not "+action+" commit ["+conflicting.commit.Hash.String()+"] because the following files were changed on the current branch: "+strings.Join(merges[0].conflicts, ", "),
+		)
+		return nil
+	}
+	for i, merge := range merges {
+		if len(merge.changes) == 0 {
+			diag.AddError(
+				"Cannot "+action+" empty commit",
+				"Could not "+action+" commit ["+picks[i].commit.Hash.String()+"] because its changes are already part of the current branch.",
+			)
+			return nil
+		}
+	}
+
+	status := getStatus(ctx, worktree, diag)
+	if status == nil {
+		return nil
+	}
+	untracked := make([]string, 0)
+	for _, merge := range merges {
+		untracked = append(untracked, overwrittenUntrackedFiles(status, merge)...)
+	}
+	if len(untracked) > 0 {
+		diag.AddError(
+			"Cannot overwrite untracked files",
+			"The untracked files ["+strings.Join(untracked, ", ")+"] would be overwritten. Move or remove them first.",
+		)
+		return nil
+	}
+
+	return commitPicks(ctx, worktree, headHash, picks, merges, options, diag)
+}
+
+// getCommitter returns the committer configured in the repository. Reports an error in case none is configured.
+func getCommitter(ctx context.Context, repository *git.Repository, diag *diag.Diagnostics) *object.Signature {
+	cfg := readConfig(ctx, repository, "system", diag)
+	if cfg == nil {
+		return nil
+	}
+	if cfg.Committer.Name != "" && cfg.Committer.Email != "" {
+		return &object.Signature{Name: cfg.Committer.Name, Email: cfg.Committer.Email, When: time.Now()}
+	}
+	if cfg.User.Name != "" && cfg.User.Email != "" {
+		return &object.Signature{Name: cfg.User.Name, Email: cfg.User.Email, When: time.Now()}
+	}
+	diag.AddError(
+		"Cannot determine committer",
+		"Neither 'committer' nor 'author' is specified and the Git configuration contains neither 'committer.name' and 'committer.email' nor 'user.name' and 'user.email'.",
+	)
+	return nil
+}
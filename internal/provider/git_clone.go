@@ -17,14 +17,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-func CreateCloneOptions(ctx context.Context, inputs *cloneResourceModel, diag *diag.Diagnostics) *git.CloneOptions {
-	options := &git.CloneOptions{}
-
+// cloneURL returns the URL as it is written into the configuration of a cloned repository.
+func cloneURL(url string) string {
 	if runtime.GOOS == "windows" {
-		options.URL = strings.ReplaceAll(inputs.URL.ValueString(), "/", `\`)
-	} else {
-		options.URL = inputs.URL.ValueString()
+		return strings.ReplaceAll(url, "/", `\`)
 	}
+	return url
+}
+
+func CreateCloneOptions(ctx context.Context, inputs *CloneResourceModel, defaults *GitProviderModel, diag *diag.Diagnostics) *git.CloneOptions {
+	options := &git.CloneOptions{}
+
+	options.URL = cloneURL(inputs.URL.ValueString())
 
 	tflog.Trace(ctx, "using 'URL'", map[string]interface{}{
 		"URL": inputs.URL.ValueString(),
@@ -35,13 +39,48 @@ func CreateCloneOptions(ctx context.Context, inputs *cloneResourceModel, diag *d
 		"RemoteName": inputs.RemoteName.ValueString(),
 	})
 
-	options.InsecureSkipTLS = inputs.InsecureSkipTls.ValueBool()
+	options.Depth = int(inputs.Depth.ValueInt64())
+	tflog.Trace(ctx, "using 'Depth'", map[string]interface{}{
+		"Depth": inputs.Depth.ValueInt64(),
+	})
+
+	options.SingleBranch = inputs.SingleBranch.ValueBool()
+	tflog.Trace(ctx, "using 'SingleBranch'", map[string]interface{}{
+		"SingleBranch": inputs.SingleBranch.ValueBool(),
+	})
+
+	options.NoCheckout = inputs.NoCheckout.ValueBool()
+	tflog.Trace(ctx, "using 'NoCheckout'", map[string]interface{}{
+		"NoCheckout": inputs.NoCheckout.ValueBool(),
+	})
+
+	if !inputs.Tags.IsNull() {
+		options.Tags = mapTagMode(inputs.Tags.ValueString())
+		tflog.Trace(ctx, "using 'Tags'", map[string]interface{}{
+			"Tags": inputs.Tags.ValueString(),
+		})
+	}
+
+	if inputs.RecurseSubmodules.ValueBool() {
+		options.RecurseSubmodules = git.DefaultSubmoduleRecursionDepth
+		tflog.Trace(ctx, "using 'RecurseSubmodules'", map[string]interface{}{
+			"RecurseSubmodules": options.RecurseSubmodules,
+		})
+	}
+
+	options.ShallowSubmodules = inputs.ShallowSubmodules.ValueBool()
+	tflog.Trace(ctx, "using 'ShallowSubmodules'", map[string]interface{}{
+		"ShallowSubmodules": inputs.ShallowSubmodules.ValueBool(),
+	})
+
+	options.InsecureSkipTLS = defaultInsecureSkipTls(inputs.InsecureSkipTls, defaults)
 	tflog.Trace(ctx, "using 'InsecureSkipTls'", map[string]interface{}{
-		"InsecureSkipTls": inputs.InsecureSkipTls.ValueBool(),
+		"InsecureSkipTls": options.InsecureSkipTLS,
 	})
 
-	if len(inputs.CaBundleFilePath.ValueString()) > 0 {
-		caBundle, err := os.ReadFile(inputs.CaBundleFilePath.ValueString())
+	caBundleFilePath := defaultCaBundleFilePath(inputs.CaBundleFilePath, defaults)
+	if len(caBundleFilePath) > 0 {
+		caBundle, err := os.ReadFile(caBundleFilePath)
 		if err != nil {
 			diag.AddError(
 				"Invalid CA bundle file path",
@@ -51,7 +90,7 @@ func CreateCloneOptions(ctx context.Context, inputs *cloneResourceModel, diag *d
 		}
 		options.CABundle = caBundle
 		tflog.Trace(ctx, "using 'CaBundleFilePath'", map[string]interface{}{
-			"CaBundleFilePath": inputs.CaBundleFilePath.ValueString(),
+			"CaBundleFilePath": caBundleFilePath,
 		})
 	}
 
@@ -62,11 +101,9 @@ func CreateCloneOptions(ctx context.Context, inputs *cloneResourceModel, diag *d
 		})
 	}
 
-	if !inputs.Auth.IsNull() {
-		options.Auth = authOptions(ctx, inputs.Auth, diag)
-		if diag.HasError() {
-			return nil
-		}
+	options.Auth = authOptions(ctx, inputs.Auth, defaults, diag)
+	if diag.HasError() {
+		return nil
 	}
 
 	return options
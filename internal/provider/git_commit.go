@@ -13,6 +13,7 @@ import (
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -60,7 +61,7 @@ func signatureToObjectWithoutTimestamp(signature *object.Signature) types.Object
 	)
 }
 
-func createCommitOptions(ctx context.Context, inputs commitResourceModel) *git.CommitOptions {
+func createCommitOptions(ctx context.Context, inputs commitResourceModel, defaults *GitProviderModel, diag *diag.Diagnostics) *git.CommitOptions {
 	options := &git.CommitOptions{}
 
 	options.All = inputs.All.ValueBool()
@@ -73,22 +74,41 @@ func createCommitOptions(ctx context.Context, inputs commitResourceModel) *git.C
 		"allow empty commits": options.AllowEmptyCommits,
 	})
 
-	if !inputs.Author.IsNull() && !inputs.Author.IsUnknown() {
-		options.Author = objectToSignature(&inputs.Author)
+	author := inputs.Author
+	if (author.IsNull() || author.IsUnknown()) && defaults != nil {
+		author = defaults.Author
+	}
+	if !author.IsNull() && !author.IsUnknown() {
+		options.Author = objectToSignature(&author)
 		tflog.Trace(ctx, "using 'Author'", map[string]interface{}{
 			"name":  options.Author.Name,
 			"email": options.Author.Email,
 		})
 	}
 
-	if !inputs.Committer.IsNull() && !inputs.Committer.IsUnknown() {
-		options.Committer = objectToSignature(&inputs.Committer)
+	committer := inputs.Committer
+	if (committer.IsNull() || committer.IsUnknown()) && defaults != nil {
+		committer = defaults.Committer
+	}
+	if !committer.IsNull() && !committer.IsUnknown() {
+		options.Committer = objectToSignature(&committer)
 		tflog.Trace(ctx, "using 'Committer'", map[string]interface{}{
 			"name":  options.Committer.Name,
 			"email": options.Committer.Email,
 		})
 	}
 
+	if !inputs.Signing.IsNull() && !inputs.Signing.IsUnknown() {
+		if signingFormat(inputs.Signing) == "ssh" {
+			options.Signer = sshSigningKey(ctx, inputs.Signing, diag)
+		} else {
+			options.SignKey = openpgpSigningKey(ctx, inputs.Signing, diag)
+		}
+		if diag.HasError() {
+			return nil
+		}
+	}
+
 	return options
 }
 
@@ -6,14 +6,32 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/go-git/gcfg"
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/osfs"
+	"github.com/go-git/go-billy/v5/util"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	format "github.com/go-git/go-git/v5/plumbing/format/config"
+	"github.com/go-git/go-git/v5/storage/filesystem"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// configKeyPattern matches configuration keys like 'section.name' and 'section.subsection.name'.
+var configKeyPattern = regexp.MustCompile(`^[A-Za-z0-9-]+(\..*)?\.[A-Za-z][A-Za-z0-9-]*$`)
+
 func mapConfigScope(userInput string) config.Scope {
 	switch userInput {
 	case "local":
@@ -40,3 +58,567 @@ func readConfig(ctx context.Context, repository *git.Repository, scope string, d
 	})
 	return cfg
 }
+
+// configFile returns the file which holds the configuration of the given scope. The global configuration is written to
+// the same file go-git reads it from, or '~/.gitconfig' in case no such file exists yet.
+func configFile(repository *git.Repository, scope string, diag *diag.Diagnostics) (billy.Basic, string) {
+	if mapConfigScope(scope) == config.LocalScope {
+		storage, ok := repository.Storer.(*filesystem.Storage)
+		if !ok {
+			diag.AddError(
+				"Cannot find config",
+				"The repository is not stored on the filesystem.",
+			)
+			return nil, ""
+		}
+		return storage.Filesystem(), "config"
+	}
+
+	paths, err := config.Paths(mapConfigScope(scope))
+	if err != nil {
+		diag.AddError(
+			"Cannot find config",
+			"Could not find "+scope+" git config because of: "+err.Error(),
+		)
+		return nil, ""
+	}
+	for _, path := range paths {
+		if _, err = os.Stat(path); err == nil {
+			return osfs.Default, path
+		}
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		diag.AddError(
+			"Cannot find config",
+			"Could not find home directory because of: "+err.Error(),
+		)
+		return nil, ""
+	}
+	return osfs.Default, filepath.Join(home, ".gitconfig")
+}
+
+// readRawConfig reads the configuration file of the given scope without merging it with any other scope.
+func readRawConfig(ctx context.Context, repository *git.Repository, scope string, diag *diag.Diagnostics) *format.Config {
+	fs, path := configFile(repository, scope, diag)
+	if fs == nil {
+		return nil
+	}
+
+	raw := format.New()
+	content, err := util.ReadFile(fs, path)
+	if errors.Is(err, os.ErrNotExist) {
+		return raw
+	} else if err != nil {
+		diag.AddError(
+			"Error reading config",
+			"Could not read git config ["+path+"] because of: "+err.Error(),
+		)
+		return nil
+	}
+	err = format.NewDecoder(bytes.NewReader(content)).Decode(raw)
+	if err != nil {
+		diag.AddError(
+			"Error reading config",
+			"Could not parse git config ["+path+"] because of: "+err.Error(),
+		)
+		return nil
+	}
+
+	tflog.Trace(ctx, "read raw config", map[string]interface{}{
+		"scope": scope,
+		"path":  path,
+	})
+	return raw
+}
+
+// configChange replaces all values of a configuration key. A change without values removes the key.
+type configChange struct {
+	key    string
+	values []string
+}
+
+// updateConfigFile applies the given changes to the configuration file of the given scope. Similar to 'git config',
+// only the lines of the changed keys are rewritten while comments, blank lines, and all other lines are kept as-is.
+func updateConfigFile(ctx context.Context, repository *git.Repository, scope string, changes []configChange, diag *diag.Diagnostics) {
+	fs, path := configFile(repository, scope, diag)
+	if fs == nil {
+		return
+	}
+
+	content, err := util.ReadFile(fs, path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		diag.AddError(
+			"Error reading config",
+			"Could not read git config ["+path+"] because of: "+err.Error(),
+		)
+		return
+	}
+
+	updated := string(content)
+	for _, change := range changes {
+		updated = editConfig(updated, change)
+	}
+	if updated == string(content) {
+		return
+	}
+
+	err = util.WriteFile(fs, path, []byte(updated), 0644)
+	if err != nil {
+		diag.AddError(
+			"Error writing config",
+			"Could not write git config ["+path+"] because of: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "wrote config", map[string]interface{}{
+		"scope":   scope,
+		"path":    path,
+		"changes": len(changes),
+	})
+}
+
+// configLine is a single line of a configuration file. Options spanning multiple lines are kept in a single entry.
+type configLine struct {
+	text       string
+	header     bool
+	section    string
+	subsection string
+	legacy     bool
+	inline     bool
+	name       string
+}
+
+// matches returns true if the line is the header of the given section and subsection. Subsections are case-sensitive
+// unless they use the deprecated '[section.subsection]' syntax.
+func (l configLine) matches(section string, subsection string) bool {
+	if !l.header || !strings.EqualFold(l.section, section) {
+		return false
+	}
+	if l.legacy {
+		return strings.EqualFold(l.subsection, subsection)
+	}
+	return l.subsection == subsection
+}
+
+// editConfig replaces all values of the changed key in the given configuration. New values are written at the position
+// of the first existing value, at the end of the last matching section, or into a new section at the end of the
+// configuration. Sections that become empty are removed unless they contain comments.
+func editConfig(content string, change configChange) string {
+	section, subsection, name := splitConfigKey(change.key)
+	added := make([]string, 0, len(change.values))
+	for _, value := range change.values {
+		added = append(added, "\t"+name+" = "+encodeConfigValue(value)+"\n")
+	}
+
+	result := make([]string, 0)
+	touched := make([]int, 0)
+	matching := false
+	inserted := false
+	headerAt := -1
+	insertAt := -1
+	for _, line := range parseConfigLines(content) {
+		if line.header {
+			matching = line.matches(section, subsection) && !line.inline
+			if matching {
+				headerAt = len(result)
+				insertAt = headerAt + 1
+			}
+			result = append(result, line.text)
+			continue
+		}
+		if matching && line.name != "" {
+			if strings.EqualFold(line.name, name) {
+				if len(touched) == 0 || touched[len(touched)-1] != headerAt {
+					touched = append(touched, headerAt)
+				}
+				if !inserted {
+					result = append(result, added...)
+					inserted = true
+				}
+				insertAt = len(result)
+				continue
+			}
+			result = append(result, line.text)
+			insertAt = len(result)
+			continue
+		}
+		result = append(result, line.text)
+	}
+
+	if !inserted && len(added) > 0 {
+		if insertAt >= 0 {
+			result = append(result[:insertAt], append(added, result[insertAt:]...)...)
+		} else {
+			if len(result) > 0 && !strings.HasSuffix(result[len(result)-1], "\n") {
+				result[len(result)-1] += "\n"
+			}
+			result = append(result, encodeConfigHeader(section, subsection))
+			result = append(result, added...)
+		}
+	}
+
+	if len(added) == 0 {
+		for i := len(touched) - 1; i >= 0; i-- {
+			result = removeEmptyConfigSection(result, touched[i])
+		}
+	}
+	return strings.Join(result, "")
+}
+
+// removeEmptyConfigSection removes the section starting at the given line in case it contains nothing but blank lines.
+func removeEmptyConfigSection(lines []string, header int) []string {
+	end := header + 1
+	for ; end < len(lines); end++ {
+		trimmed := strings.TrimSpace(lines[end])
+		if strings.HasPrefix(trimmed, "[") {
+			break
+		}
+		if trimmed != "" {
+			return lines
+		}
+	}
+	return append(lines[:header], lines[end:]...)
+}
+
+// parseConfigLines splits the given configuration into its lines.
+func parseConfigLines(content string) []configLine {
+	lines := make([]configLine, 0)
+	physical := strings.SplitAfter(content, "\n")
+	for i := 0; i < len(physical); i++ {
+		text := physical[i]
+		if text == "" {
+			continue
+		}
+		trimmed := strings.TrimSpace(text)
+		if strings.HasPrefix(trimmed, "[") {
+			lines = append(lines, parseConfigHeader(text, trimmed))
+			continue
+		}
+		name := configOptionName(trimmed)
+		if name == "" {
+			lines = append(lines, configLine{text: text})
+			continue
+		}
+		for continuesConfigValue(strings.TrimRight(physical[i], "\r\n")) && i+1 < len(physical) {
+			i++
+			text += physical[i]
+		}
+		lines = append(lines, configLine{text: text, name: name})
+	}
+	return lines
+}
+
+// parseConfigHeader parses section headers in the forms '[section]', '[section "subsection"]', and the deprecated
+// '[section.subsection]'. Headers followed by an option on the same line are marked as inline.
+func parseConfigHeader(text string, trimmed string) configLine {
+	line := configLine{text: text, header: true}
+	end := 1
+	for end < len(trimmed) && (isConfigNameChar(trimmed[end]) || trimmed[end] == '.') {
+		end++
+	}
+	line.section = trimmed[1:end]
+	if before, after, found := strings.Cut(line.section, "."); found {
+		line.section = before
+		line.subsection = after
+		line.legacy = true
+	}
+
+	rest := strings.TrimLeft(trimmed[end:], " \t")
+	if strings.HasPrefix(rest, `"`) {
+		var subsection strings.Builder
+		i := 1
+		for ; i < len(rest) && rest[i] != '"'; i++ {
+			if rest[i] == '\\' && i+1 < len(rest) {
+				i++
+			}
+			subsection.WriteByte(rest[i])
+		}
+		line.subsection = subsection.String()
+		rest = rest[min(i+1, len(rest)):]
+	}
+
+	rest = strings.TrimSpace(strings.TrimPrefix(rest, "]"))
+	line.inline = rest != "" && !strings.HasPrefix(rest, "#") && !strings.HasPrefix(rest, ";")
+	return line
+}
+
+// configOptionName returns the name of the option defined in the given line, or an empty string for comments, blank
+// lines, and anything else which does not define an option.
+func configOptionName(trimmed string) string {
+	if trimmed == "" || !(trimmed[0] >= 'a' && trimmed[0] <= 'z' || trimmed[0] >= 'A' && trimmed[0] <= 'Z') {
+		return ""
+	}
+	end := 0
+	for end < len(trimmed) && isConfigNameChar(trimmed[end]) {
+		end++
+	}
+	rest := strings.TrimLeft(trimmed[end:], " \t")
+	if rest != "" && rest[0] != '=' && rest[0] != '#' && rest[0] != ';' {
+		return ""
+	}
+	return trimmed[:end]
+}
+
+func isConfigNameChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-'
+}
+
+// continuesConfigValue returns true if the given line ends with a backslash that continues its value on the next line.
+func continuesConfigValue(line string) bool {
+	quoted := false
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			if i == len(line)-1 {
+				return true
+			}
+			i++
+		case '"':
+			quoted = !quoted
+		case '#', ';':
+			if !quoted {
+				return false
+			}
+		}
+	}
+	return false
+}
+
+// encodeConfigHeader returns the header of the given section using the same format as the go-git encoder.
+func encodeConfigHeader(section string, subsection string) string {
+	if subsection == "" {
+		return "[" + section + "]\n"
+	}
+	return "[" + section + ` "` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(subsection) + `"]` + "\n"
+}
+
+// encodeConfigValue quotes and escapes the given value using the same rules as the go-git encoder.
+func encodeConfigValue(value string) string {
+	if strings.ContainsAny(value, "#;\"\t\n\\") || strings.HasPrefix(value, " ") || strings.HasSuffix(value, " ") {
+		return `"` + strings.NewReplacer(`"`, `\"`, `\`, `\\`, "\n", `\n`, "\t", `\t`, "\b", `\b`).Replace(value) + `"`
+	}
+	return value
+}
+
+// splitConfigKey splits a key like 'section.subsection.name' into its parts. The subsection is optional and may
+// contain dots itself, e.g. 'url.git@github.com:.insteadOf'.
+func splitConfigKey(key string) (string, string, string) {
+	first := strings.Index(key, ".")
+	last := strings.LastIndex(key, ".")
+	if first == last {
+		return key[:first], "", key[last+1:]
+	}
+	return key[:first], key[first+1 : last], key[last+1:]
+}
+
+// getConfigValues returns all values of the given key in the order they appear in the configuration.
+func getConfigValues(raw *format.Config, key string) []string {
+	section, subsection, name := splitConfigKey(key)
+	if !raw.HasSection(section) {
+		return []string{}
+	}
+	if subsection == "" {
+		return raw.Section(section).OptionAll(name)
+	}
+	if !raw.Section(section).HasSubsection(subsection) {
+		return []string{}
+	}
+	return raw.Section(section).Subsection(subsection).OptionAll(name)
+}
+
+// configEntry is a single key/value pair of a configuration file. Keys use the canonical form of 'git config --list'
+// which has a lowercase section and name while the subsection keeps its case.
+type configEntry struct {
+	key   string
+	value string
+}
+
+// maxConfigIncludeDepth limits the depth of nested includes similar to git.
+const maxConfigIncludeDepth = 10
+
+// configIncludeContext holds the information required to evaluate the conditions of 'includeIf' sections.
+type configIncludeContext struct {
+	gitDir string
+	branch string
+}
+
+// canonicalConfigKey returns the given key in the form 'git config --list' uses.
+func canonicalConfigKey(key string) string {
+	section, subsection, name := splitConfigKey(key)
+	if subsection == "" {
+		return strings.ToLower(section) + "." + strings.ToLower(name)
+	}
+	return strings.ToLower(section) + "." + subsection + "." + strings.ToLower(name)
+}
+
+// readConfigEntries reads all configuration entries of the given scope in the order git reads them. Similar to
+// readConfig, the 'global' scope includes the 'local' scope, and the 'system' scope includes both. Files referenced
+// by 'include' and matching 'includeIf' sections are expanded at the position of their directive.
+func readConfigEntries(ctx context.Context, repository *git.Repository, scope string, diag *diag.Diagnostics) []configEntry {
+	common := commonDirectory(repository, diag)
+	if common == "" {
+		return nil
+	}
+
+	include := configIncludeContext{}
+	if storage, ok := repository.Storer.(*filesystem.Storage); ok {
+		include.gitDir = storage.Filesystem().Root()
+	}
+	if head, err := repository.Reference(plumbing.HEAD, false); err == nil && head.Type() == plumbing.SymbolicReference {
+		include.branch = head.Target().Short()
+	}
+
+	files := make([]string, 0)
+	if mapConfigScope(scope) >= config.SystemScope {
+		paths, err := config.Paths(config.SystemScope)
+		if err != nil {
+			diag.AddError(
+				"Error reading config",
+				"Could not find system git config because of: "+err.Error(),
+			)
+			return nil
+		}
+		files = append(files, paths...)
+	}
+	if mapConfigScope(scope) >= config.GlobalScope {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			diag.AddError(
+				"Error reading config",
+				"Could not find home directory because of: "+err.Error(),
+			)
+			return nil
+		}
+		xdg := os.Getenv("XDG_CONFIG_HOME")
+		if xdg == "" {
+			xdg = filepath.Join(home, ".config")
+		}
+		files = append(files, filepath.Join(xdg, "git", "config"), filepath.Join(home, ".gitconfig"))
+	}
+	files = append(files, filepath.Join(common, "config"))
+
+	entries := make([]configEntry, 0)
+	for _, file := range files {
+		err := appendConfigEntries(&entries, file, include, 0)
+		if err != nil {
+			diag.AddError(
+				"Error reading config",
+				"Could not read git config ["+file+"] because of: "+err.Error(),
+			)
+			return nil
+		}
+	}
+
+	tflog.Trace(ctx, "read config entries", map[string]interface{}{
+		"scope":   scope,
+		"entries": len(entries),
+	})
+	return entries
+}
+
+// appendConfigEntries appends the entries of the given file and the files it includes. Missing files are ignored.
+func appendConfigEntries(entries *[]configEntry, file string, include configIncludeContext, depth int) error {
+	if depth > maxConfigIncludeDepth {
+		return errors.New("exceeded maximum include depth while including [" + file + "]")
+	}
+	content, err := os.ReadFile(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return gcfg.ReadWithCallback(bytes.NewReader(content), func(section string, subsection string, name string, value string, _ bool) error {
+		if name == "" {
+			return nil
+		}
+		key := strings.ToLower(section) + "." + strings.ToLower(name)
+		if subsection != "" {
+			key = strings.ToLower(section) + "." + subsection + "." + strings.ToLower(name)
+		}
+		*entries = append(*entries, configEntry{key: key, value: value})
+
+		if key != "include.path" && !(strings.EqualFold(section, "includeIf") && strings.EqualFold(name, "path")) {
+			return nil
+		}
+		if key != "include.path" && !include.matches(subsection, file) {
+			return nil
+		}
+		included := resolveConfigPath(value, file)
+		if errInclude := appendConfigEntries(entries, included, include, depth+1); errInclude != nil {
+			return fmt.Errorf("could not include [%s]: %w", included, errInclude)
+		}
+		return nil
+	})
+}
+
+// resolveConfigPath resolves a path used in a configuration file relative to the directory of that file.
+func resolveConfigPath(path string, file string) string {
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, rest)
+		}
+	}
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(filepath.Dir(file), path)
+}
+
+// matches returns true if the given condition of an 'includeIf' section is satisfied. The 'gitdir', 'gitdir/i', and
+// 'onbranch' conditions are supported. Any other condition is never satisfied.
+func (c configIncludeContext) matches(condition string, file string) bool {
+	if pattern, ok := strings.CutPrefix(condition, "gitdir:"); ok {
+		return c.matchesGitDir(pattern, file, false)
+	}
+	if pattern, ok := strings.CutPrefix(condition, "gitdir/i:"); ok {
+		return c.matchesGitDir(pattern, file, true)
+	}
+	if pattern, ok := strings.CutPrefix(condition, "onbranch:"); ok {
+		if c.branch == "" {
+			return false
+		}
+		if strings.HasSuffix(pattern, "/") {
+			pattern += "**"
+		}
+		matched, err := doublestar.Match(pattern, c.branch)
+		return err == nil && matched
+	}
+	return false
+}
+
+func (c configIncludeContext) matchesGitDir(pattern string, file string, ignoreCase bool) bool {
+	if c.gitDir == "" {
+		return false
+	}
+	directory := strings.HasSuffix(pattern, "/")
+	switch {
+	case strings.HasPrefix(pattern, "~/"):
+		pattern = resolveConfigPath(pattern, file)
+	case strings.HasPrefix(pattern, "./"):
+		pattern = filepath.Join(filepath.Dir(file), pattern[2:])
+	case !filepath.IsAbs(pattern):
+		pattern = "**/" + pattern
+	}
+	if directory {
+		pattern = strings.TrimSuffix(pattern, "/") + "/**"
+	}
+
+	candidates := []string{c.gitDir}
+	if resolved, err := filepath.EvalSymlinks(c.gitDir); err == nil && resolved != c.gitDir {
+		candidates = append(candidates, resolved)
+	}
+	for _, candidate := range candidates {
+		candidate = filepath.ToSlash(candidate)
+		if ignoreCase {
+			candidate = strings.ToLower(candidate)
+			pattern = strings.ToLower(pattern)
+		}
+		if matched, err := doublestar.Match(filepath.ToSlash(pattern), candidate); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
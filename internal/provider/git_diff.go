@@ -0,0 +1,384 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	fdiff "github.com/go-git/go-git/v5/plumbing/format/diff"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/go-git/go-git/v5/utils/merkletrie"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// overlayStorage keeps the trees and blobs of the index and the worktree in memory while reading all other objects
+// from the repository. This allows to diff them with the same machinery used for commits without writing any objects
+// into the repository itself.
+type overlayStorage struct {
+	*memory.ObjectStorage
+	fallback storer.EncodedObjectStorer
+}
+
+func newOverlayStorage(repository *git.Repository) *overlayStorage {
+	return &overlayStorage{
+		ObjectStorage: &memory.NewStorage().ObjectStorage,
+		fallback:      repository.Storer,
+	}
+}
+
+func (s *overlayStorage) EncodedObject(objectType plumbing.ObjectType, hash plumbing.Hash) (plumbing.EncodedObject, error) {
+	obj, err := s.ObjectStorage.EncodedObject(objectType, hash)
+	if errors.Is(err, plumbing.ErrObjectNotFound) {
+		return s.fallback.EncodedObject(objectType, hash)
+	}
+	return obj, err
+}
+
+func (s *overlayStorage) HasEncodedObject(hash plumbing.Hash) error {
+	if err := s.ObjectStorage.HasEncodedObject(hash); err == nil {
+		return nil
+	}
+	return s.fallback.HasEncodedObject(hash)
+}
+
+func (s *overlayStorage) EncodedObjectSize(hash plumbing.Hash) (int64, error) {
+	size, err := s.ObjectStorage.EncodedObjectSize(hash)
+	if errors.Is(err, plumbing.ErrObjectNotFound) {
+		return s.fallback.EncodedObjectSize(hash)
+	}
+	return size, err
+}
+
+// revisionTree returns the tree of the commit the given revision points to.
+func revisionTree(ctx context.Context, repository *git.Repository, revision string, diag *diag.Diagnostics) *object.Tree {
+	hash := resolveRevision(ctx, repository, revision, diag)
+	if hash == nil {
+		return nil
+	}
+	commit := getCommit(ctx, repository, hash, diag)
+	if commit == nil {
+		return nil
+	}
+	return getTree(ctx, commit, diag)
+}
+
+// headTree returns the tree of 'HEAD' or an empty tree in case the repository does not contain any commits yet.
+func headTree(ctx context.Context, repository *git.Repository, storage *overlayStorage, diag *diag.Diagnostics) *object.Tree {
+	if _, err := repository.Head(); errors.Is(err, plumbing.ErrReferenceNotFound) {
+		tflog.Trace(ctx, "using empty tree for unborn HEAD")
+		return buildTree(ctx, storage, map[string]object.TreeEntry{}, diag)
+	}
+	return revisionTree(ctx, repository, "HEAD", diag)
+}
+
+// indexEntries returns all merged entries of the index keyed by their path.
+func indexEntries(ctx context.Context, repository *git.Repository, diag *diag.Diagnostics) map[string]object.TreeEntry {
+	idx, err := repository.Storer.Index()
+	if err != nil {
+		diag.AddError(
+			"Cannot read index",
+			"Could not read index because of: "+err.Error(),
+		)
+		return nil
+	}
+
+	entries := make(map[string]object.TreeEntry)
+	for _, entry := range idx.Entries {
+		if entry.Stage != 0 {
+			// unmerged paths cannot be represented in a tree and are skipped just like 'git diff' does. The stage of
+			// merged entries is zero which does not match the 'index.Merged' constant of go-git.
+			continue
+		}
+		entries[entry.Name] = object.TreeEntry{
+			Name: entry.Name,
+			Mode: entry.Mode,
+			Hash: entry.Hash,
+		}
+	}
+	tflog.Trace(ctx, "read index entries", map[string]interface{}{
+		"entries": len(entries),
+	})
+	return entries
+}
+
+// indexTree returns a tree which contains all files currently staged in the index.
+func indexTree(ctx context.Context, repository *git.Repository, storage *overlayStorage, diag *diag.Diagnostics) *object.Tree {
+	entries := indexEntries(ctx, repository, diag)
+	if entries == nil {
+		return nil
+	}
+	return buildTree(ctx, storage, entries, diag)
+}
+
+// worktreeTree returns a tree which contains all tracked files with their current content in the worktree. Similar to
+// 'git diff', untracked files are not part of the tree.
+func worktreeTree(ctx context.Context, repository *git.Repository, worktree *git.Worktree, storage *overlayStorage, diag *diag.Diagnostics) *object.Tree {
+	entries := indexEntries(ctx, repository, diag)
+	if entries == nil {
+		return nil
+	}
+	status := getStatus(ctx, worktree, diag)
+	if status == nil {
+		return nil
+	}
+
+	for name, fileStatus := range status {
+		entry, tracked := entries[name]
+		if !tracked || entry.Mode == filemode.Submodule {
+			continue
+		}
+		switch fileStatus.Worktree {
+		case git.Deleted:
+			delete(entries, name)
+		case git.Modified:
+			worktreeEntry, err := worktreeBlob(worktree, storage, name)
+			if err != nil {
+				diag.AddError(
+					"Cannot read file",
+					"Could not read file ["+name+"] because of: "+err.Error(),
+				)
+				return nil
+			}
+			entries[name] = worktreeEntry
+		}
+	}
+	return buildTree(ctx, storage, entries, diag)
+}
+
+// worktreeBlob stores the current content of the given file in the worktree as a blob.
+func worktreeBlob(worktree *git.Worktree, storage *overlayStorage, name string) (object.TreeEntry, error) {
+	info, err := worktree.Filesystem.Lstat(name)
+	if err != nil {
+		return object.TreeEntry{}, err
+	}
+
+	var content []byte
+	mode := filemode.Regular
+	if info.Mode()&os.ModeSymlink != 0 {
+		target, err := worktree.Filesystem.Readlink(name)
+		if err != nil {
+			return object.TreeEntry{}, err
+		}
+		content = []byte(target)
+		mode = filemode.Symlink
+	} else {
+		file, err := worktree.Filesystem.Open(name)
+		if err != nil {
+			return object.TreeEntry{}, err
+		}
+		defer file.Close()
+		content, err = io.ReadAll(file)
+		if err != nil {
+			return object.TreeEntry{}, err
+		}
+		if info.Mode().Perm()&0111 != 0 {
+			mode = filemode.Executable
+		}
+	}
+
+	blob := storage.NewEncodedObject()
+	blob.SetType(plumbing.BlobObject)
+	writer, err := blob.Writer()
+	if err != nil {
+		return object.TreeEntry{}, err
+	}
+	if _, err = writer.Write(content); err != nil {
+		return object.TreeEntry{}, err
+	}
+	if err = writer.Close(); err != nil {
+		return object.TreeEntry{}, err
+	}
+	hash, err := storage.SetEncodedObject(blob)
+	if err != nil {
+		return object.TreeEntry{}, err
+	}
+
+	return object.TreeEntry{
+		Name: name,
+		Mode: mode,
+		Hash: hash,
+	}, nil
+}
+
+// buildTree writes the nested trees for the given entries keyed by their full path into the storage.
+func buildTree(ctx context.Context, storage *overlayStorage, entries map[string]object.TreeEntry, diag *diag.Diagnostics) *object.Tree {
+	hash, err := writeTree(storage, entries)
+	if err != nil {
+		diag.AddError(
+			"Cannot write tree",
+			"Could not write tree because of: "+err.Error(),
+		)
+		return nil
+	}
+	tree, err := object.GetTree(storage, hash)
+	if err != nil {
+		diag.AddError(
+			"Cannot read tree",
+			"Could not read tree ["+hash.String()+"] because of: "+err.Error(),
+		)
+		return nil
+	}
+	tflog.Trace(ctx, "built tree", map[string]interface{}{
+		"hash":    hash.String(),
+		"entries": len(entries),
+	})
+	return tree
+}
+
+func writeTree(storage *overlayStorage, entries map[string]object.TreeEntry) (plumbing.Hash, error) {
+	tree := &object.Tree{}
+	directories := make(map[string]map[string]object.TreeEntry)
+	for name, entry := range entries {
+		directory, rest, nested := strings.Cut(name, "/")
+		if !nested {
+			entry.Name = name
+			tree.Entries = append(tree.Entries, entry)
+			continue
+		}
+		if directories[directory] == nil {
+			directories[directory] = make(map[string]object.TreeEntry)
+		}
+		directories[directory][rest] = entry
+	}
+	for directory, children := range directories {
+		hash, err := writeTree(storage, children)
+		if err != nil {
+			return plumbing.ZeroHash, err
+		}
+		tree.Entries = append(tree.Entries, object.TreeEntry{
+			Name: directory,
+			Mode: filemode.Dir,
+			Hash: hash,
+		})
+	}
+
+	// Git sorts tree entries as if directories had a trailing slash
+	sort.Slice(tree.Entries, func(i, j int) bool {
+		return treeEntrySortName(tree.Entries[i]) < treeEntrySortName(tree.Entries[j])
+	})
+
+	obj := storage.NewEncodedObject()
+	if err := tree.Encode(obj); err != nil {
+		return plumbing.ZeroHash, err
+	}
+	return storage.SetEncodedObject(obj)
+}
+
+func treeEntrySortName(entry object.TreeEntry) string {
+	if entry.Mode == filemode.Dir {
+		return entry.Name + "/"
+	}
+	return entry.Name
+}
+
+// diffTrees compares both trees and returns the changes of all files matching one of the given patterns. All files
+// are compared in case no patterns are given. Similar to 'git diff -- <paths>' renames are only detected between
+// matching files.
+func diffTrees(ctx context.Context, from *object.Tree, to *object.Tree, patterns []string, renames bool, diag *diag.Diagnostics) object.Changes {
+	changes, err := object.DiffTreeWithOptions(ctx, from, to, nil)
+	if err != nil {
+		diag.AddError(
+			"Cannot compare trees",
+			"Could not compare tree ["+from.Hash.String()+"] with ["+to.Hash.String()+"] because of: "+err.Error(),
+		)
+		return nil
+	}
+
+	matching := make(object.Changes, 0, len(changes))
+	for _, change := range changes {
+		if matchesPatterns(change.From.Name, patterns) || matchesPatterns(change.To.Name, patterns) {
+			matching = append(matching, change)
+		}
+	}
+
+	if renames {
+		matching, err = object.DetectRenames(matching, object.DefaultDiffTreeOptions)
+		if err != nil {
+			diag.AddError(
+				"Cannot detect renames",
+				"Could not detect renamed files because of: "+err.Error(),
+			)
+			return nil
+		}
+		sort.Slice(matching, func(i, j int) bool {
+			return changePath(matching[i]) < changePath(matching[j])
+		})
+	}
+
+	tflog.Trace(ctx, "compared trees", map[string]interface{}{
+		"from":    from.Hash.String(),
+		"to":      to.Hash.String(),
+		"changes": len(matching),
+	})
+	return matching
+}
+
+func matchesPatterns(name string, patterns []string) bool {
+	if name == "" {
+		return false
+	}
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if match, err := doublestar.PathMatch(pattern, name); err == nil && match {
+			return true
+		}
+	}
+	return false
+}
+
+// changeStatus maps the given change to the status names used by 'git diff --name-status'.
+func changeStatus(change *object.Change) string {
+	action, err := change.Action()
+	if err != nil {
+		return ""
+	}
+	switch action {
+	case merkletrie.Insert:
+		return "added"
+	case merkletrie.Delete:
+		return "deleted"
+	}
+	if change.From.Name != change.To.Name {
+		return "renamed"
+	}
+	return "modified"
+}
+
+// filePatchStats counts the added and deleted lines of the given file patch. Binary files have no line based stats.
+func filePatchStats(filePatch fdiff.FilePatch) (int64, int64) {
+	var additions, deletions int64
+	for _, chunk := range filePatch.Chunks() {
+		content := chunk.Content()
+		if len(content) == 0 {
+			continue
+		}
+		lines := int64(strings.Count(content, "\n"))
+		if !strings.HasSuffix(content, "\n") {
+			lines++
+		}
+		switch chunk.Type() {
+		case fdiff.Add:
+			additions += lines
+		case fdiff.Delete:
+			deletions += lines
+		}
+	}
+	return additions, deletions
+}
@@ -0,0 +1,128 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"os"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func CreateFetchOptions(ctx context.Context, inputs *FetchResourceModel, defaults *GitProviderModel, diag *diag.Diagnostics) *git.FetchOptions {
+	options := &git.FetchOptions{}
+
+	if len(inputs.RefSpecs.Elements()) > 0 {
+		refSpecs := make([]config.RefSpec, len(inputs.RefSpecs.Elements()))
+		diag.Append(inputs.RefSpecs.ElementsAs(ctx, &refSpecs, false)...)
+		if diag.HasError() {
+			return nil
+		}
+		options.RefSpecs = refSpecs
+		tflog.Trace(ctx, "using 'RefSpecs'", map[string]interface{}{
+			"RefSpecs": refSpecs,
+		})
+	}
+
+	options.RemoteName = inputs.Remote.ValueString()
+	tflog.Trace(ctx, "using 'RemoteName'", map[string]interface{}{
+		"RemoteName": inputs.Remote.ValueString(),
+	})
+
+	options.Depth = int(inputs.Depth.ValueInt64())
+	tflog.Trace(ctx, "using 'Depth'", map[string]interface{}{
+		"Depth": inputs.Depth.ValueInt64(),
+	})
+
+	options.Prune = inputs.Prune.ValueBool()
+	tflog.Trace(ctx, "using 'Prune'", map[string]interface{}{
+		"Prune": inputs.Prune.ValueBool(),
+	})
+
+	options.Force = inputs.Force.ValueBool()
+	tflog.Trace(ctx, "using 'Force'", map[string]interface{}{
+		"Force": inputs.Force.ValueBool(),
+	})
+
+	options.Tags = mapTagMode(inputs.Tags.ValueString())
+	tflog.Trace(ctx, "using 'Tags'", map[string]interface{}{
+		"Tags": inputs.Tags.ValueString(),
+	})
+
+	options.InsecureSkipTLS = defaultInsecureSkipTls(inputs.InsecureSkipTls, defaults)
+	tflog.Trace(ctx, "using 'InsecureSkipTls'", map[string]interface{}{
+		"InsecureSkipTls": options.InsecureSkipTLS,
+	})
+
+	caBundleFilePath := defaultCaBundleFilePath(inputs.CaBundleFilePath, defaults)
+	if len(caBundleFilePath) > 0 {
+		caBundle, err := os.ReadFile(caBundleFilePath)
+		if err != nil {
+			diag.AddError(
+				"Invalid CA bundle file path",
+				err.Error(),
+			)
+			return nil
+		}
+		options.CABundle = caBundle
+		tflog.Trace(ctx, "using 'CaBundleFilePath'", map[string]interface{}{
+			"CaBundleFilePath": caBundleFilePath,
+		})
+	}
+
+	options.Auth = authOptions(ctx, inputs.Auth, defaults, diag)
+	if diag.HasError() {
+		return nil
+	}
+
+	return options
+}
+
+func mapTagMode(mode string) git.TagMode {
+	switch mode {
+	case "all":
+		return git.AllTags
+	case "none":
+		return git.NoTags
+	default:
+		return git.TagFollowing
+	}
+}
+
+func getReferenceHashes(ctx context.Context, repository *git.Repository, diag *diag.Diagnostics) map[plumbing.ReferenceName]plumbing.Hash {
+	references, err := repository.References()
+	if err != nil {
+		diag.AddError(
+			"Cannot read references",
+			"Could not read references because of: "+err.Error(),
+		)
+		return nil
+	}
+
+	hashes := make(map[plumbing.ReferenceName]plumbing.Hash)
+	err = references.ForEach(func(reference *plumbing.Reference) error {
+		if reference.Type() == plumbing.HashReference {
+			hashes[reference.Name()] = reference.Hash()
+		}
+		return nil
+	})
+	if err != nil {
+		diag.AddError(
+			"Cannot read references",
+			"Could not read references because of: "+err.Error(),
+		)
+		return nil
+	}
+
+	tflog.Trace(ctx, "read references", map[string]interface{}{
+		"references": len(hashes),
+	})
+	return hashes
+}
@@ -0,0 +1,216 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/format/index"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func fileContent(content types.String, contentBase64 types.String, diag *diag.Diagnostics) []byte {
+	if !contentBase64.IsNull() {
+		decoded, err := base64.StdEncoding.DecodeString(contentBase64.ValueString())
+		if err != nil {
+			diag.AddError(
+				"Invalid base64 content",
+				"Could not decode 'content_base64' because of: "+err.Error(),
+			)
+			return nil
+		}
+		return decoded
+	}
+	return []byte(content.ValueString())
+}
+
+func fileMode(mode types.String, diag *diag.Diagnostics) os.FileMode {
+	parsed, err := strconv.ParseUint(mode.ValueString(), 8, 32)
+	if err != nil {
+		diag.AddError(
+			"Invalid file mode",
+			"Could not parse file mode ["+mode.ValueString()+"] because of: "+err.Error(),
+		)
+		return 0
+	}
+	return os.FileMode(parsed)
+}
+
+func blobHash(content []byte) plumbing.Hash {
+	return plumbing.ComputeHash(plumbing.BlobObject, content)
+}
+
+func writeWorktreeFile(ctx context.Context, worktree *git.Worktree, name string, content []byte, mode os.FileMode, diag *diag.Diagnostics) {
+	filename := filepath.Join(worktree.Filesystem.Root(), name)
+
+	err := os.MkdirAll(filepath.Dir(filename), 0755)
+	if err != nil {
+		diag.AddError(
+			"Cannot write file",
+			"Could not create parent directories of file ["+name+"] because of: "+err.Error(),
+		)
+		return
+	}
+
+	err = os.WriteFile(filename, content, mode)
+	if err == nil {
+		// WriteFile does not change the permissions of existing files
+		err = os.Chmod(filename, mode)
+	}
+	if err != nil {
+		diag.AddError(
+			"Cannot write file",
+			"Could not write file ["+name+"] because of: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = worktree.Add(filepath.ToSlash(name))
+	if err != nil {
+		diag.AddError(
+			"Cannot add file",
+			"Could not add file ["+name+"] to the index because of: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "wrote file", map[string]interface{}{
+		"file": name,
+		"mode": mode.String(),
+	})
+}
+
+// isWorktreePath returns true if the given path is relative to the root of the worktree and does not escape it.
+func isWorktreePath(name string, diag *diag.Diagnostics) bool {
+	if !filepath.IsLocal(filepath.Clean(name)) {
+		diag.AddError(
+			"Invalid file path",
+			"The path ["+name+"] must be relative to the root of the worktree and must not escape it.",
+		)
+		return false
+	}
+	return true
+}
+
+func removeWorktreeFile(ctx context.Context, worktree *git.Worktree, name string, diag *diag.Diagnostics) {
+	_, err := worktree.Remove(filepath.ToSlash(name))
+	if errors.Is(err, index.ErrEntryNotFound) {
+		// the file was never staged or its deletion has already been staged
+		err = os.Remove(filepath.Join(worktree.Filesystem.Root(), name))
+		if os.IsNotExist(err) {
+			err = nil
+		}
+	}
+	if err != nil {
+		diag.AddError(
+			"Cannot remove file",
+			"Could not remove file ["+name+"] because of: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "removed file", map[string]interface{}{
+		"file": name,
+	})
+}
+
+// getIndexHash returns the blob hash of the given file in the index or nil in case the file is not staged.
+func getIndexHash(ctx context.Context, repository *git.Repository, name string, diag *diag.Diagnostics) *plumbing.Hash {
+	idx, err := repository.Storer.Index()
+	if err != nil {
+		diag.AddError(
+			"Cannot read index",
+			"Could not read index because of: "+err.Error(),
+		)
+		return nil
+	}
+
+	entry, err := idx.Entry(filepath.ToSlash(name))
+	if errors.Is(err, index.ErrEntryNotFound) {
+		return nil
+	} else if err != nil {
+		diag.AddError(
+			"Cannot read index",
+			"Could not read index entry of file ["+name+"] because of: "+err.Error(),
+		)
+		return nil
+	}
+
+	tflog.Trace(ctx, "read index entry", map[string]interface{}{
+		"file": name,
+		"hash": entry.Hash.String(),
+	})
+	return &entry.Hash
+}
+
+// readTreeFile returns the file at the given path of the tree along with its content.
+func readTreeFile(ctx context.Context, tree *object.Tree, name string, diag *diag.Diagnostics) (*object.File, []byte) {
+	entry, err := tree.FindEntry(name)
+	if errors.Is(err, object.ErrEntryNotFound) || errors.Is(err, object.ErrDirectoryNotFound) {
+		diag.AddError(
+			"Cannot read file",
+			"Could not find file ["+name+"] in tree ["+tree.Hash.String()+"]",
+		)
+		return nil, nil
+	} else if err != nil {
+		diag.AddError(
+			"Cannot read file",
+			"Could not read file ["+name+"] because of: "+err.Error(),
+		)
+		return nil, nil
+	}
+	if !entry.Mode.IsFile() {
+		diag.AddError(
+			"Cannot read file",
+			"The path ["+name+"] in tree ["+tree.Hash.String()+"] is not a file but has mode ["+entry.Mode.String()+"]",
+		)
+		return nil, nil
+	}
+
+	file, err := tree.TreeEntryFile(entry)
+	if err != nil {
+		diag.AddError(
+			"Cannot read file",
+			"Could not read file ["+name+"] because of: "+err.Error(),
+		)
+		return nil, nil
+	}
+	reader, err := file.Reader()
+	if err != nil {
+		diag.AddError(
+			"Cannot read file",
+			"Could not read content of file ["+name+"] because of: "+err.Error(),
+		)
+		return nil, nil
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		diag.AddError(
+			"Cannot read file",
+			"Could not read content of file ["+name+"] because of: "+err.Error(),
+		)
+		return nil, nil
+	}
+
+	tflog.Trace(ctx, "read tree file", map[string]interface{}{
+		"tree": tree.Hash.String(),
+		"file": name,
+		"hash": file.Hash.String(),
+	})
+	return file, content
+}
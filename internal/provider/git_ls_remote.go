@@ -0,0 +1,60 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"os"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/go-git/go-git/v5"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// peeledSuffix marks the entries of a reference listing that point to the target of an annotated tag.
+const peeledSuffix = "^{}"
+
+func createListOptions(ctx context.Context, inputs *lsRemoteDataSourceModel, defaults *GitProviderModel, diag *diag.Diagnostics) *git.ListOptions {
+	options := &git.ListOptions{
+		PeelingOption: git.AppendPeeled,
+	}
+
+	options.InsecureSkipTLS = defaultInsecureSkipTls(inputs.InsecureSkipTls, defaults)
+	tflog.Trace(ctx, "using 'InsecureSkipTls'", map[string]interface{}{
+		"InsecureSkipTls": options.InsecureSkipTLS,
+	})
+
+	caBundleFilePath := defaultCaBundleFilePath(inputs.CaBundleFilePath, defaults)
+	if len(caBundleFilePath) > 0 {
+		caBundle, err := os.ReadFile(caBundleFilePath)
+		if err != nil {
+			diag.AddError(
+				"Invalid CA bundle file path",
+				err.Error(),
+			)
+			return nil
+		}
+		options.CABundle = caBundle
+		tflog.Trace(ctx, "using 'CaBundleFilePath'", map[string]interface{}{
+			"CaBundleFilePath": caBundleFilePath,
+		})
+	}
+
+	options.Auth = authOptions(ctx, inputs.Auth, defaults, diag)
+
+	return options
+}
+
+// matchesReference reports whether the full name of a reference matches the given glob pattern. Every reference
+// matches an empty pattern.
+func matchesReference(name string, pattern string) bool {
+	if pattern == "" {
+		return true
+	}
+	match, err := doublestar.Match(pattern, name)
+	return err == nil && match
+}
@@ -0,0 +1,351 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// mergeChange is a file which has to be written into the worktree in order to apply a merge. A change with an empty
+// entry removes the file.
+type mergeChange struct {
+	path  string
+	entry object.TreeEntry
+	tree  *object.Tree
+}
+
+type treeMerge struct {
+	changes   []mergeChange
+	conflicts []string
+}
+
+// mergeTrees performs a file based three-way merge. Changes between 'base' and 'theirs' are applied on top of 'ours'
+// unless 'ours' changed the same file in a different way, in which case the file is reported as a conflict.
+func mergeTrees(ctx context.Context, base *object.Tree, ours *object.Tree, theirs *object.Tree, diag *diag.Diagnostics) *treeMerge {
+	ourChanges, err := object.DiffTreeWithOptions(ctx, base, ours, nil)
+	if err != nil {
+		diag.AddError(
+			"Cannot compare trees",
+			"Could not compare tree ["+base.Hash.String()+"] with ["+ours.Hash.String()+"] because of: "+err.Error(),
+		)
+		return nil
+	}
+	theirChanges, err := object.DiffTreeWithOptions(ctx, base, theirs, nil)
+	if err != nil {
+		diag.AddError(
+			"Cannot compare trees",
+			"Could not compare tree ["+base.Hash.String()+"] with ["+theirs.Hash.String()+"] because of: "+err.Error(),
+		)
+		return nil
+	}
+
+	ourEntries := make(map[string]object.TreeEntry)
+	for _, change := range ourChanges {
+		ourEntries[changePath(change)] = change.To.TreeEntry
+	}
+
+	merge := &treeMerge{
+		changes:   make([]mergeChange, 0),
+		conflicts: make([]string, 0),
+	}
+	for _, change := range theirChanges {
+		name := changePath(change)
+		entry := change.To.TreeEntry
+		if ourEntry, ok := ourEntries[name]; ok {
+			if ourEntry.Hash != entry.Hash || ourEntry.Mode != entry.Mode {
+				merge.conflicts = append(merge.conflicts, name)
+			}
+			continue
+		}
+		if entry.Mode == filemode.Submodule {
+			// the worktree cannot check out submodule commits, thus they have to be merged by hand
+			merge.conflicts = append(merge.conflicts, name)
+			continue
+		}
+		merge.changes = append(merge.changes, mergeChange{
+			path:  name,
+			entry: entry,
+			tree:  theirs,
+		})
+	}
+	sort.Strings(merge.conflicts)
+
+	tflog.Trace(ctx, "merged trees", map[string]interface{}{
+		"base":      base.Hash.String(),
+		"ours":      ours.Hash.String(),
+		"theirs":    theirs.Hash.String(),
+		"changes":   len(merge.changes),
+		"conflicts": merge.conflicts,
+	})
+	return merge
+}
+
+func changePath(change *object.Change) string {
+	if change.To.Name != "" {
+		return change.To.Name
+	}
+	return change.From.Name
+}
+
+// hasLocalChanges returns true if the index or the tracked files in the worktree differ from HEAD.
+func hasLocalChanges(status git.Status) bool {
+	for _, fileStatus := range status {
+		if fileStatus.Staging != git.Unmodified && fileStatus.Staging != git.Untracked {
+			return true
+		}
+		if fileStatus.Worktree != git.Unmodified && fileStatus.Worktree != git.Untracked {
+			return true
+		}
+	}
+	return false
+}
+
+// overwrittenUntrackedFiles returns the untracked files in the worktree that would be overwritten by the given merge.
+func overwrittenUntrackedFiles(status git.Status, merge *treeMerge) []string {
+	files := make([]string, 0)
+	for _, change := range merge.changes {
+		if fileStatus, ok := status[change.path]; ok && fileStatus.Worktree == git.Untracked {
+			files = append(files, change.path)
+		}
+	}
+	sort.Strings(files)
+	return files
+}
+
+// applyTreeMerge writes the changes of the given merge into the worktree and stages them.
+func applyTreeMerge(ctx context.Context, worktree *git.Worktree, merge *treeMerge, diag *diag.Diagnostics) bool {
+	for _, change := range merge.changes {
+		if change.entry.Hash.IsZero() {
+			if _, err := worktree.Remove(change.path); err != nil {
+				diag.AddError(
+					"Cannot remove file",
+					"Could not remove file ["+change.path+"] because of: "+err.Error(),
+				)
+				return false
+			}
+			continue
+		}
+
+		if err := writeTreeEntry(worktree, change); err != nil {
+			diag.AddError(
+				"Cannot write file",
+				"Could not write file ["+change.path+"] because of: "+err.Error(),
+			)
+			return false
+		}
+		if _, err := worktree.Add(change.path); err != nil {
+			diag.AddError(
+				"Cannot add file",
+				"Could not add file ["+change.path+"] because of: "+err.Error(),
+			)
+			return false
+		}
+	}
+
+	tflog.Trace(ctx, "applied merge", map[string]interface{}{
+		"changes": len(merge.changes),
+	})
+	return true
+}
+
+// resetTreeMerges restores the files changed by the given merges to their content at the given commit, which also
+// becomes the new tip of the current branch. Only the changed files are reset, thus untracked files are kept.
+func resetTreeMerges(ctx context.Context, worktree *git.Worktree, commit plumbing.Hash, merges []*treeMerge, diag *diag.Diagnostics) {
+	files := make([]string, 0)
+	for _, merge := range merges {
+		for _, change := range merge.changes {
+			files = append(files, change.path)
+		}
+	}
+
+	err := worktree.Reset(&git.ResetOptions{
+		Commit: commit,
+		Mode:   git.HardReset,
+		Files:  files,
+	})
+	if err != nil {
+		diag.AddError(
+			"Cannot reset worktree",
+			"Could not reset the worktree to ["+commit.String()+"] because of: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "reset merged files", map[string]interface{}{
+		"commit": commit.String(),
+		"files":  len(files),
+	})
+}
+
+func writeTreeEntry(worktree *git.Worktree, change mergeChange) error {
+	file, err := change.tree.TreeEntryFile(&change.entry)
+	if err != nil {
+		return err
+	}
+	reader, err := file.Reader()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+
+	if _, err = worktree.Filesystem.Lstat(change.path); err == nil {
+		if err = worktree.Filesystem.Remove(change.path); err != nil {
+			return err
+		}
+	}
+
+	if change.entry.Mode == filemode.Symlink {
+		return worktree.Filesystem.Symlink(string(content), change.path)
+	}
+
+	mode, err := change.entry.Mode.ToOSFileMode()
+	if err != nil {
+		return err
+	}
+	return util.WriteFile(worktree.Filesystem, change.path, content, mode.Perm())
+}
+
+// mergeBase returns the best common ancestor of both commits or nil in case they do not share any history.
+func mergeBase(ctx context.Context, ours *object.Commit, theirs *object.Commit, diag *diag.Diagnostics) *object.Commit {
+	bases, err := ours.MergeBase(theirs)
+	if err != nil {
+		diag.AddError(
+			"Cannot find merge base",
+			"Could not find merge base of ["+ours.Hash.String()+"] and ["+theirs.Hash.String()+"] because of: "+err.Error(),
+		)
+		return nil
+	}
+	if len(bases) == 0 {
+		diag.AddError(
+			"Cannot merge unrelated histories",
+			"The commits ["+ours.Hash.String()+"] and ["+theirs.Hash.String()+"] do not share a common ancestor.",
+		)
+		return nil
+	}
+	tflog.Trace(ctx, "found merge base", map[string]interface{}{
+		"ours":   ours.Hash.String(),
+		"theirs": theirs.Hash.String(),
+		"base":   bases[0].Hash.String(),
+	})
+	return bases[0]
+}
+
+// isAncestor returns true if the first commit is reachable from the second one.
+func isAncestor(ctx context.Context, ancestor *object.Commit, commit *object.Commit, diag *diag.Diagnostics) bool {
+	if ancestor.Hash == commit.Hash {
+		return true
+	}
+	result, err := ancestor.IsAncestor(commit)
+	if err != nil {
+		diag.AddError(
+			"Cannot read history",
+			"Could not determine whether ["+ancestor.Hash.String()+"] is an ancestor of ["+commit.Hash.String()+"] because of: "+err.Error(),
+		)
+		return false
+	}
+	tflog.Trace(ctx, "checked ancestry", map[string]interface{}{
+		"ancestor": ancestor.Hash.String(),
+		"commit":   commit.Hash.String(),
+		"result":   result,
+	})
+	return result
+}
+
+func createMergeCommit(ctx context.Context, inputs mergeResourceModel, ours *object.Commit, theirs *object.Commit, defaults *GitProviderModel, worktree *git.Worktree, diag *diag.Diagnostics) *plumbing.Hash {
+	options := createCommitOptions(ctx, commitResourceModel{
+		Author:    inputs.Author,
+		Committer: inputs.Committer,
+		Signing:   inputs.Signing,
+	}, defaults, diag)
+	if options == nil {
+		return nil
+	}
+	options.AllowEmptyCommits = true
+	options.Parents = []plumbing.Hash{ours.Hash, theirs.Hash}
+
+	message := "Merge " + inputs.Revision.ValueString()
+	if !inputs.Message.IsNull() {
+		message = inputs.Message.ValueString()
+	}
+
+	return createCommit(worktree, message, options, diag)
+}
+
+func getTree(ctx context.Context, commit *object.Commit, diag *diag.Diagnostics) *object.Tree {
+	tree, err := commit.Tree()
+	if err != nil {
+		diag.AddError(
+			"Cannot read tree",
+			"Could not read tree of commit ["+commit.Hash.String()+"] because of: "+err.Error(),
+		)
+		return nil
+	}
+	tflog.Trace(ctx, "read tree", map[string]interface{}{
+		"commit": commit.Hash.String(),
+		"tree":   tree.Hash.String(),
+	})
+	return tree
+}
+
+// threeWayMerge applies the changes between 'base' and 'theirs' on top of 'ours' which must be checked out in the
+// worktree. The changes are written into the worktree and staged, but not committed. Returns false and the conflicting
+// files in case the changes cannot be applied. Files which were already written are reset to 'ours' in case applying
+// the changes fails half-way, thus the worktree is left untouched in either case.
+func threeWayMerge(ctx context.Context, worktree *git.Worktree, base *object.Commit, ours *object.Commit, theirs *object.Commit, diag *diag.Diagnostics) (bool, []string) {
+	baseTree := getTree(ctx, base, diag)
+	if baseTree == nil {
+		return false, nil
+	}
+	ourTree := getTree(ctx, ours, diag)
+	if ourTree == nil {
+		return false, nil
+	}
+	theirTree := getTree(ctx, theirs, diag)
+	if theirTree == nil {
+		return false, nil
+	}
+
+	merge := mergeTrees(ctx, baseTree, ourTree, theirTree, diag)
+	if merge == nil {
+		return false, nil
+	}
+	if len(merge.conflicts) > 0 {
+		return false, merge.conflicts
+	}
+
+	status := getStatus(ctx, worktree, diag)
+	if status == nil {
+		return false, nil
+	}
+	if untracked := overwrittenUntrackedFiles(status, merge); len(untracked) > 0 {
+		diag.AddError(
+			"Cannot overwrite untracked files",
+			"The untracked files ["+strings.Join(untracked, ", ")+"] would be overwritten. Move or remove them first.",
+		)
+		return false, nil
+	}
+
+	if !applyTreeMerge(ctx, worktree, merge, diag) {
+		resetTreeMerges(ctx, worktree, ours.Hash, []*treeMerge{merge}, diag)
+		return false, nil
+	}
+	return true, nil
+}
@@ -0,0 +1,85 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func CreatePullOptions(ctx context.Context, inputs *PullResourceModel, defaults *GitProviderModel, diag *diag.Diagnostics) *git.PullOptions {
+	options := &git.PullOptions{}
+
+	options.RemoteName = inputs.RemoteName.ValueString()
+	tflog.Trace(ctx, "using 'RemoteName'", map[string]interface{}{
+		"RemoteName": inputs.RemoteName.ValueString(),
+	})
+
+	if len(inputs.ReferenceName.ValueString()) > 0 {
+		options.ReferenceName = expandBranchReferenceName(inputs.ReferenceName.ValueString())
+		tflog.Trace(ctx, "using 'ReferenceName'", map[string]interface{}{
+			"ReferenceName": options.ReferenceName.String(),
+		})
+	}
+
+	options.SingleBranch = inputs.SingleBranch.ValueBool()
+	tflog.Trace(ctx, "using 'SingleBranch'", map[string]interface{}{
+		"SingleBranch": inputs.SingleBranch.ValueBool(),
+	})
+
+	options.Depth = int(inputs.Depth.ValueInt64())
+	tflog.Trace(ctx, "using 'Depth'", map[string]interface{}{
+		"Depth": inputs.Depth.ValueInt64(),
+	})
+
+	options.Force = inputs.Force.ValueBool()
+	tflog.Trace(ctx, "using 'Force'", map[string]interface{}{
+		"Force": inputs.Force.ValueBool(),
+	})
+
+	options.InsecureSkipTLS = defaultInsecureSkipTls(inputs.InsecureSkipTls, defaults)
+	tflog.Trace(ctx, "using 'InsecureSkipTls'", map[string]interface{}{
+		"InsecureSkipTls": options.InsecureSkipTLS,
+	})
+
+	caBundleFilePath := defaultCaBundleFilePath(inputs.CaBundleFilePath, defaults)
+	if len(caBundleFilePath) > 0 {
+		caBundle, err := os.ReadFile(caBundleFilePath)
+		if err != nil {
+			diag.AddError(
+				"Invalid CA bundle file path",
+				err.Error(),
+			)
+			return nil
+		}
+		options.CABundle = caBundle
+		tflog.Trace(ctx, "using 'CaBundleFilePath'", map[string]interface{}{
+			"CaBundleFilePath": caBundleFilePath,
+		})
+	}
+
+	options.Auth = authOptions(ctx, inputs.Auth, defaults, diag)
+	if diag.HasError() {
+		return nil
+	}
+
+	return options
+}
+
+// expandBranchReferenceName turns short branch names like 'main' into fully qualified reference names like
+// 'refs/heads/main'. Names that are already fully qualified are returned as-is.
+func expandBranchReferenceName(name string) plumbing.ReferenceName {
+	if strings.HasPrefix(name, "refs/") {
+		return plumbing.ReferenceName(name)
+	}
+	return plumbing.NewBranchReferenceName(name)
+}
@@ -7,15 +7,26 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"os"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-func CreatePushOptions(ctx context.Context, inputs *PushResourceModel, diag *diag.Diagnostics) *git.PushOptions {
+// updatedRefType is the type of a single entry in the 'updated_refs' attribute of git_push.
+var updatedRefType = map[string]attr.Type{
+	"old_sha1": types.StringType,
+	"new_sha1": types.StringType,
+}
+
+func CreatePushOptions(ctx context.Context, inputs *PushResourceModel, defaults *GitProviderModel, diag *diag.Diagnostics) *git.PushOptions {
 	options := &git.PushOptions{}
 
 	if len(inputs.RefSpecs.Elements()) > 0 {
@@ -47,13 +58,51 @@ func CreatePushOptions(ctx context.Context, inputs *PushResourceModel, diag *dia
 		"Force": inputs.Force.ValueBool(),
 	})
 
-	options.InsecureSkipTLS = inputs.InsecureSkipTls.ValueBool()
+	options.Atomic = inputs.Atomic.ValueBool()
+	tflog.Trace(ctx, "using 'Atomic'", map[string]interface{}{
+		"Atomic": inputs.Atomic.ValueBool(),
+	})
+
+	options.FollowTags = inputs.FollowTags.ValueBool()
+	tflog.Trace(ctx, "using 'FollowTags'", map[string]interface{}{
+		"FollowTags": inputs.FollowTags.ValueBool(),
+	})
+
+	if !inputs.ForceWithLease.IsNull() && !inputs.ForceWithLease.IsUnknown() {
+		lease := &git.ForceWithLease{}
+		if refName, ok := inputs.ForceWithLease.Attributes()["ref_name"].(types.String); ok && !refName.IsNull() {
+			lease.RefName = plumbing.ReferenceName(refName.ValueString())
+		}
+		if sha1, ok := inputs.ForceWithLease.Attributes()["sha1"].(types.String); ok && !sha1.IsNull() {
+			lease.Hash = plumbing.NewHash(sha1.ValueString())
+		}
+		options.ForceWithLease = lease
+		tflog.Trace(ctx, "using 'ForceWithLease'", map[string]interface{}{
+			"RefName": lease.RefName.String(),
+			"Hash":    lease.Hash.String(),
+		})
+	}
+
+	if len(inputs.Options.Elements()) > 0 {
+		pushOptions := make(map[string]string, len(inputs.Options.Elements()))
+		diag.Append(inputs.Options.ElementsAs(ctx, &pushOptions, false)...)
+		if diag.HasError() {
+			return nil
+		}
+		options.Options = pushOptions
+		tflog.Trace(ctx, "using 'Options'", map[string]interface{}{
+			"Options": pushOptions,
+		})
+	}
+
+	options.InsecureSkipTLS = defaultInsecureSkipTls(inputs.InsecureSkipTls, defaults)
 	tflog.Trace(ctx, "using 'InsecureSkipTls'", map[string]interface{}{
-		"InsecureSkipTls": inputs.InsecureSkipTls.ValueBool(),
+		"InsecureSkipTls": options.InsecureSkipTLS,
 	})
 
-	if len(inputs.CaBundleFilePath.ValueString()) > 0 {
-		caBundle, err := os.ReadFile(inputs.CaBundleFilePath.ValueString())
+	caBundleFilePath := defaultCaBundleFilePath(inputs.CaBundleFilePath, defaults)
+	if len(caBundleFilePath) > 0 {
+		caBundle, err := os.ReadFile(caBundleFilePath)
 		if err != nil {
 			diag.AddError(
 				"Invalid CA bundle file path",
@@ -63,16 +112,96 @@ func CreatePushOptions(ctx context.Context, inputs *PushResourceModel, diag *dia
 		}
 		options.CABundle = caBundle
 		tflog.Trace(ctx, "using 'CaBundleFilePath'", map[string]interface{}{
-			"CaBundleFilePath": inputs.CaBundleFilePath.ValueString(),
+			"CaBundleFilePath": caBundleFilePath,
 		})
 	}
 
-	if !inputs.Auth.IsNull() {
-		options.Auth = authOptions(ctx, inputs.Auth, diag)
-		if diag.HasError() {
-			return nil
-		}
+	options.Auth = authOptions(ctx, inputs.Auth, defaults, diag)
+	if diag.HasError() {
+		return nil
 	}
 
 	return options
 }
+
+// getRemoteReferenceHashes lists the references advertised by the remote a push is targeting. An empty remote
+// repository advertises no references at all.
+func getRemoteReferenceHashes(ctx context.Context, remote *git.Remote, options *git.PushOptions, diag *diag.Diagnostics) map[plumbing.ReferenceName]plumbing.Hash {
+	refs, err := remote.ListContext(ctx, &git.ListOptions{
+		Auth:            options.Auth,
+		InsecureSkipTLS: options.InsecureSkipTLS,
+		CABundle:        options.CABundle,
+		PeelingOption:   git.IgnorePeeled,
+	})
+	if err != nil && !errors.Is(err, transport.ErrEmptyRemoteRepository) {
+		diag.AddError(
+			"Cannot list remote",
+			"Could not list remote ["+options.RemoteName+"] because of: "+err.Error(),
+		)
+		return nil
+	}
+
+	hashes := make(map[plumbing.ReferenceName]plumbing.Hash)
+	for _, ref := range refs {
+		if ref.Type() == plumbing.HashReference {
+			hashes[ref.Name()] = ref.Hash()
+		}
+	}
+
+	tflog.Trace(ctx, "read remote references", map[string]interface{}{
+		"remote":     options.RemoteName,
+		"references": len(hashes),
+	})
+	return hashes
+}
+
+func hashValue(hashes map[plumbing.ReferenceName]plumbing.Hash, name plumbing.ReferenceName) types.String {
+	if hash, ok := hashes[name]; ok {
+		return types.StringValue(hash.String())
+	}
+	return types.StringNull()
+}
+
+// getPushedReferences maps the remote refs targeted by the given refspecs to the hash of their local counterpart.
+func getPushedReferences(refSpecs []config.RefSpec, local map[plumbing.ReferenceName]plumbing.Hash) map[plumbing.ReferenceName]plumbing.Hash {
+	pushed := make(map[plumbing.ReferenceName]plumbing.Hash)
+	for _, refSpec := range refSpecs {
+		if refSpec.IsDelete() {
+			continue
+		}
+		for name, hash := range local {
+			if refSpec.Match(name) {
+				pushed[refSpec.Dst(name)] = hash
+			}
+		}
+	}
+	return pushed
+}
+
+// getUnpushedReferences maps the remote refs targeted by the given refspecs to the hash of their local counterpart
+// in case the remote ref does not exist yet or the local ref is ahead of it. Remote refs which are ahead of or have
+// diverged from their local counterpart are left alone.
+func getUnpushedReferences(repository *git.Repository, refSpecs []config.RefSpec, local map[plumbing.ReferenceName]plumbing.Hash, remote map[plumbing.ReferenceName]plumbing.Hash) map[plumbing.ReferenceName]plumbing.Hash {
+	unpushed := make(map[plumbing.ReferenceName]plumbing.Hash)
+	for destination, hash := range getPushedReferences(refSpecs, local) {
+		if remoteHash, ok := remote[destination]; !ok || (remoteHash != hash && isFastForward(repository, remoteHash, hash)) {
+			unpushed[destination] = hash
+		}
+	}
+	return unpushed
+}
+
+// isFastForward returns true if the commit of the remote ref is an ancestor of the local commit. Commits unknown to the
+// local repository were pushed by someone else, thus the remote ref is not behind.
+func isFastForward(repository *git.Repository, remoteHash plumbing.Hash, localHash plumbing.Hash) bool {
+	remoteCommit, err := repository.CommitObject(remoteHash)
+	if err != nil {
+		return false
+	}
+	localCommit, err := repository.CommitObject(localHash)
+	if err != nil {
+		return false
+	}
+	result, err := remoteCommit.IsAncestor(localCommit)
+	return err == nil && result
+}
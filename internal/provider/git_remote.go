@@ -7,8 +7,13 @@ package provider
 
 import (
 	"context"
+	"errors"
 
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/storage/memory"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -27,3 +32,44 @@ func getRemote(ctx context.Context, repository *git.Repository, remoteName strin
 	})
 	return remote
 }
+
+// findRemoteReference looks up the reference with the given name in the references advertised by a remote. Symbolic
+// references like HEAD are resolved to the reference they point to. Returns nil in case the remote does not advertise
+// the reference.
+func findRemoteReference(refs []*plumbing.Reference, name plumbing.ReferenceName) *plumbing.Reference {
+	for _, ref := range refs {
+		if ref.Name() != name {
+			continue
+		}
+		if ref.Type() == plumbing.SymbolicReference {
+			return findRemoteReference(refs, ref.Target())
+		}
+		return ref
+	}
+	return nil
+}
+
+// listRemoteReferences lists the references of the remote repository at the given URL similar to 'git ls-remote'
+// without requiring a local repository. Empty remote repositories have no references.
+func listRemoteReferences(ctx context.Context, url string, options *git.ListOptions, diag *diag.Diagnostics) []*plumbing.Reference {
+	remote := git.NewRemote(memory.NewStorage(), &config.RemoteConfig{
+		Name: "origin",
+		URLs: []string{url},
+	})
+	refs, err := remote.List(options)
+	if errors.Is(err, transport.ErrEmptyRemoteRepository) {
+		return make([]*plumbing.Reference, 0)
+	} else if err != nil {
+		diag.AddError(
+			"Cannot list remote",
+			"Could not list references of remote repository ["+url+"] because of: "+err.Error(),
+		)
+		return nil
+	}
+
+	tflog.Trace(ctx, "listed remote references", map[string]interface{}{
+		"url":        url,
+		"references": len(refs),
+	})
+	return refs
+}
@@ -18,7 +18,7 @@ import (
 func openRepository(ctx context.Context, directory string, diag *diag.Diagnostics) *git.Repository {
 	repository, err := git.PlainOpenWithOptions(directory, &git.PlainOpenOptions{
 		DetectDotGit:          false,
-		EnableDotGitCommonDir: false,
+		EnableDotGitCommonDir: true,
 	})
 	if err != nil {
 		// we are trying to open the repository again, this time by searching upward for a .git folder
@@ -26,7 +26,7 @@ func openRepository(ctx context.Context, directory string, diag *diag.Diagnostic
 		// we cannot always enable this detection mechanism because it fails for bare repositories.
 		repository, err = git.PlainOpenWithOptions(directory, &git.PlainOpenOptions{
 			DetectDotGit:          true,
-			EnableDotGitCommonDir: false,
+			EnableDotGitCommonDir: true,
 		})
 		if err != nil {
 			diag.AddError(
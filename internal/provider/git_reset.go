@@ -0,0 +1,241 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func mapResetMode(userInput string) git.ResetMode {
+	switch userInput {
+	case "soft":
+		return git.SoftReset
+	case "hard":
+		return git.HardReset
+	case "merge":
+		return git.MergeReset
+	default:
+		return git.MixedReset
+	}
+}
+
+// createResetOptions creates the options to reset to the given commit, which is the resolved 'revision' of the inputs.
+func createResetOptions(ctx context.Context, repository *git.Repository, inputs resetResourceModel, commit plumbing.Hash, diag *diag.Diagnostics) *git.ResetOptions {
+	options := &git.ResetOptions{}
+
+	mode := inputs.Mode.ValueString()
+	options.Mode = mapResetMode(mode)
+	tflog.Trace(ctx, "using 'Mode'", map[string]interface{}{
+		"Mode": mode,
+	})
+
+	options.Commit = commit
+	tflog.Trace(ctx, "using 'Commit'", map[string]interface{}{
+		"Commit": commit.String(),
+	})
+
+	var files []string
+	if !inputs.Files.IsNull() {
+		diag.Append(inputs.Files.ElementsAs(ctx, &files, false)...)
+		if diag.HasError() {
+			return nil
+		}
+	}
+	if len(files) > 0 && options.Mode != git.MixedReset && options.Mode != git.HardReset {
+		diag.AddError(
+			"Invalid reset options",
+			"The 'files' option can only be used with the 'mixed' and 'hard' modes.",
+		)
+		return nil
+	}
+
+	if len(files) > 0 || options.Mode == git.HardReset {
+		// go-git removes untracked files during hard resets, thus we limit every reset to the tracked files
+		tracked := trackedFiles(ctx, repository, options.Commit, diag)
+		if tracked == nil {
+			return nil
+		}
+		options.Files = matchFiles(tracked, files)
+		if len(options.Files) == 0 {
+			if len(files) > 0 {
+				diag.AddError(
+					"Invalid reset options",
+					"None of the given 'files' are tracked in the index or in revision ["+inputs.Revision.ValueString()+"].",
+				)
+				return nil
+			}
+			// neither the index nor the target tree contain any files, thus only HEAD has to move
+			options.Mode = git.SoftReset
+		}
+		tflog.Trace(ctx, "using 'Files'", map[string]interface{}{
+			"Files": options.Files,
+		})
+	}
+
+	return options
+}
+
+// trackedFiles returns the files in the index and the tree of the given commit.
+func trackedFiles(ctx context.Context, repository *git.Repository, hash plumbing.Hash, diag *diag.Diagnostics) []string {
+	index, err := repository.Storer.Index()
+	if err != nil {
+		diag.AddError(
+			"Cannot read index",
+			"Could not read index because of: "+err.Error(),
+		)
+		return nil
+	}
+	commit := getCommit(ctx, repository, &hash, diag)
+	if commit == nil {
+		return nil
+	}
+	tree := getTree(ctx, commit, diag)
+	if tree == nil {
+		return nil
+	}
+
+	unique := make(map[string]struct{})
+	for _, entry := range index.Entries {
+		unique[entry.Name] = struct{}{}
+	}
+	err = tree.Files().ForEach(func(file *object.File) error {
+		unique[file.Name] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		diag.AddError(
+			"Cannot read tree",
+			"Could not read files of commit ["+hash.String()+"] because of: "+err.Error(),
+		)
+		return nil
+	}
+
+	files := make([]string, 0, len(unique))
+	for name := range unique {
+		files = append(files, name)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// matchFiles returns the files which are equal to or contained in one of the given paths. All files are returned in
+// case no paths are given.
+func matchFiles(files []string, paths []string) []string {
+	if len(paths) == 0 {
+		return files
+	}
+	matches := make([]string, 0)
+	for _, file := range files {
+		for _, path := range paths {
+			prefix := strings.TrimSuffix(path, "/")
+			if file == prefix || strings.HasPrefix(file, prefix+"/") {
+				matches = append(matches, file)
+				break
+			}
+		}
+	}
+	return matches
+}
+
+// discardedChanges returns the files with uncommitted changes which would be lost by the given reset.
+func discardedChanges(status git.Status, options *git.ResetOptions) []string {
+	files := make([]string, 0)
+	if options.Mode != git.HardReset {
+		// soft, mixed, and merge resets keep the content of the worktree
+		return files
+	}
+	scope := make(map[string]struct{}, len(options.Files))
+	for _, file := range options.Files {
+		scope[file] = struct{}{}
+	}
+	for name, fileStatus := range status {
+		if _, ok := scope[name]; !ok {
+			continue
+		}
+		if fileStatus.Staging == git.Untracked || fileStatus.Worktree == git.Untracked {
+			continue
+		}
+		if fileStatus.Staging != git.Unmodified || fileStatus.Worktree != git.Unmodified {
+			files = append(files, name)
+		}
+	}
+	sort.Strings(files)
+	return files
+}
+
+// hasResettableChanges returns true if the index or the worktree contain changes which would be reset with the given
+// options.
+func hasResettableChanges(status git.Status, options *git.ResetOptions) bool {
+	scope := make(map[string]struct{}, len(options.Files))
+	for _, file := range options.Files {
+		scope[file] = struct{}{}
+	}
+	for name, fileStatus := range status {
+		if _, ok := scope[name]; len(scope) > 0 && !ok {
+			continue
+		}
+		staged := fileStatus.Staging != git.Unmodified && fileStatus.Staging != git.Untracked
+		modified := fileStatus.Worktree != git.Unmodified && fileStatus.Worktree != git.Untracked
+		switch options.Mode {
+		case git.HardReset:
+			if staged || modified {
+				return true
+			}
+		case git.MixedReset, git.MergeReset:
+			if staged {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// resetWorktree resets the worktree with the given options. Path limited resets keep 'HEAD' in place similar to
+// 'git reset <revision> -- <paths>'.
+func resetWorktree(ctx context.Context, repository *git.Repository, worktree *git.Worktree, options *git.ResetOptions, pathLimited bool, diag *diag.Diagnostics) bool {
+	head, err := repository.Reference(plumbing.HEAD, true)
+	if err != nil {
+		diag.AddError(
+			"Cannot read HEAD",
+			"Could not read HEAD because of: "+err.Error(),
+		)
+		return false
+	}
+
+	err = worktree.Reset(options)
+	if err != nil {
+		diag.AddError(
+			"Cannot reset",
+			"Could not reset to ["+options.Commit.String()+"] because of: "+err.Error(),
+		)
+		return false
+	}
+
+	if pathLimited {
+		err = repository.Storer.SetReference(plumbing.NewHashReference(head.Name(), head.Hash()))
+		if err != nil {
+			diag.AddError(
+				"Cannot restore HEAD",
+				"Could not restore ["+head.Name().String()+"] to ["+head.Hash().String()+"] because of: "+err.Error(),
+			)
+			return false
+		}
+	}
+
+	tflog.Trace(ctx, "reset worktree", map[string]interface{}{
+		"commit": options.Commit.String(),
+		"files":  len(options.Files),
+	})
+	return true
+}
@@ -0,0 +1,390 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	sshSignatureMagic     = "SSHSIG"
+	sshSignatureNamespace = "git"
+	sshSignatureHash      = "sha512"
+)
+
+func signingFormat(signing types.Object) string {
+	format, ok := signing.Attributes()["format"].(types.String)
+	if ok && !format.IsNull() && !format.IsUnknown() {
+		return format.ValueString()
+	}
+	return "openpgp"
+}
+
+func signingPassphrase(signing types.Object) string {
+	passphrase, ok := signing.Attributes()["passphrase"].(types.String)
+	if ok {
+		return passphrase.ValueString()
+	}
+	return ""
+}
+
+func readSigningKey(signing types.Object, diag *diag.Diagnostics) []byte {
+	keyPem, keyPemOk := signing.Attributes()["private_key_pem"].(types.String)
+	keyPath, keyPathOk := signing.Attributes()["private_key_path"].(types.String)
+
+	if keyPemOk && !keyPem.IsNull() {
+		return []byte(keyPem.ValueString())
+	} else if keyPathOk && !keyPath.IsNull() {
+		key, err := os.ReadFile(keyPath.ValueString())
+		if err != nil {
+			diag.AddError(
+				"Cannot read signing key",
+				"Could not read signing key ["+keyPath.ValueString()+"] because of: "+err.Error(),
+			)
+			return nil
+		}
+		return key
+	}
+
+	diag.AddError(
+		"Invalid signing configuration",
+		"Either path or PEM data must be specified",
+	)
+	return nil
+}
+
+func openpgpSigningKey(ctx context.Context, signing types.Object, diag *diag.Diagnostics) *openpgp.Entity {
+	key := readSigningKey(signing, diag)
+	if key == nil {
+		return nil
+	}
+
+	keyring, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(key))
+	if err != nil {
+		diag.AddError(
+			"Cannot read signing key",
+			"Could not read OpenPGP private key because of: "+err.Error(),
+		)
+		return nil
+	}
+
+	var entity *openpgp.Entity
+	for _, candidate := range keyring {
+		if candidate.PrivateKey != nil {
+			entity = candidate
+			break
+		}
+	}
+	if entity == nil {
+		diag.AddError(
+			"Cannot read signing key",
+			"The given OpenPGP key does not contain a private key",
+		)
+		return nil
+	}
+
+	if entity.PrivateKey.Encrypted {
+		err = entity.DecryptPrivateKeys([]byte(signingPassphrase(signing)))
+		if err != nil {
+			diag.AddError(
+				"Cannot decrypt signing key",
+				"Could not decrypt OpenPGP private key because of: "+err.Error(),
+			)
+			return nil
+		}
+	}
+
+	tflog.Trace(ctx, "using OpenPGP signing key", map[string]interface{}{
+		"key_id": entity.PrimaryKey.KeyIdString(),
+	})
+	return entity
+}
+
+func sshSigningKey(ctx context.Context, signing types.Object, diag *diag.Diagnostics) git.Signer {
+	key := readSigningKey(signing, diag)
+	if key == nil {
+		return nil
+	}
+
+	var signer ssh.Signer
+	var err error
+	if passphrase := signingPassphrase(signing); passphrase != "" {
+		signer, err = ssh.ParsePrivateKeyWithPassphrase(key, []byte(passphrase))
+	} else {
+		signer, err = ssh.ParsePrivateKey(key)
+	}
+	if err != nil {
+		diag.AddError(
+			"Cannot read signing key",
+			"Could not read SSH private key because of: "+err.Error(),
+		)
+		return nil
+	}
+
+	tflog.Trace(ctx, "using SSH signing key", map[string]interface{}{
+		"fingerprint": ssh.FingerprintSHA256(signer.PublicKey()),
+	})
+	return &sshSigner{signer: signer}
+}
+
+// sshSigner creates signatures in the format used by 'ssh-keygen -Y sign' which Git uses for 'gpg.format=ssh'.
+type sshSigner struct {
+	signer ssh.Signer
+}
+
+func (s *sshSigner) Sign(message io.Reader) ([]byte, error) {
+	digest := sha512.New()
+	if _, err := io.Copy(digest, message); err != nil {
+		return nil, err
+	}
+
+	signedData := sshSignedData(sshSignatureHash, digest.Sum(nil))
+
+	var signature *ssh.Signature
+	var err error
+	if algorithmSigner, ok := s.signer.(ssh.AlgorithmSigner); ok && s.signer.PublicKey().Type() == ssh.KeyAlgoRSA {
+		// SHA1 based RSA signatures are rejected by Git
+		signature, err = algorithmSigner.SignWithAlgorithm(rand.Reader, signedData, ssh.KeyAlgoRSASHA512)
+	} else {
+		signature, err = s.signer.Sign(rand.Reader, signedData)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	blob := append([]byte(sshSignatureMagic), ssh.Marshal(struct {
+		Version   uint32
+		PublicKey []byte
+		Namespace string
+		Reserved  string
+		Hash      string
+		Signature []byte
+	}{1, s.signer.PublicKey().Marshal(), sshSignatureNamespace, "", sshSignatureHash, ssh.Marshal(signature)})...)
+
+	return armorSSHSignature(blob), nil
+}
+
+// sshSignedData returns the data which is actually signed by an SSH key as described in the SSHSIG protocol.
+func sshSignedData(hashAlgorithm string, digest []byte) []byte {
+	return append([]byte(sshSignatureMagic), ssh.Marshal(struct {
+		Namespace string
+		Reserved  string
+		Hash      string
+		Digest    []byte
+	}{sshSignatureNamespace, "", hashAlgorithm, digest})...)
+}
+
+func armorSSHSignature(blob []byte) []byte {
+	encoded := base64.StdEncoding.EncodeToString(blob)
+
+	var armored strings.Builder
+	armored.WriteString("-----BEGIN SSH SIGNATURE-----\n")
+	for len(encoded) > 70 {
+		armored.WriteString(encoded[:70] + "\n")
+		encoded = encoded[70:]
+	}
+	armored.WriteString(encoded + "\n")
+	armored.WriteString("-----END SSH SIGNATURE-----\n")
+
+	return []byte(armored.String())
+}
+
+// signableObject is implemented by commits and tags.
+type signableObject interface {
+	EncodeWithoutSignature(o plumbing.EncodedObject) error
+}
+
+func readKeyring(armoredKeyRing string, diag *diag.Diagnostics) openpgp.EntityList {
+	keyring, err := openpgp.ReadArmoredKeyRing(strings.NewReader(armoredKeyRing))
+	if err != nil {
+		diag.AddError(
+			"Invalid keyring",
+			"Could not read OpenPGP public keys because of: "+err.Error(),
+		)
+		return nil
+	}
+	return keyring
+}
+
+// verifyOpenPGPSignature checks the armored detached signature of the given object against a keyring and returns
+// the key which created the signature.
+func verifyOpenPGPSignature(obj signableObject, signature string, keyring openpgp.EntityList) (*openpgp.Entity, error) {
+	if signature == "" {
+		return nil, errors.New("object is not signed")
+	}
+
+	encoded := &plumbing.MemoryObject{}
+	if err := obj.EncodeWithoutSignature(encoded); err != nil {
+		return nil, err
+	}
+	reader, err := encoded.Reader()
+	if err != nil {
+		return nil, err
+	}
+
+	return openpgp.CheckArmoredDetachedSignature(keyring, reader, strings.NewReader(signature), nil)
+}
+
+// allowedSigner is a single entry of an SSH allowed signers file as described in ssh-keygen(1).
+type allowedSigner struct {
+	principals string
+	key        ssh.PublicKey
+	namespaces []string
+}
+
+func readAllowedSigners(ctx context.Context, path string, diag *diag.Diagnostics) []allowedSigner {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		diag.AddError(
+			"Cannot read allowed signers",
+			"Could not read allowed signers file ["+path+"] because of: "+err.Error(),
+		)
+		return nil
+	}
+
+	signers := make([]allowedSigner, 0)
+	for number, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		principals, rest, _ := strings.Cut(line, " ")
+		key, _, options, _, err := ssh.ParseAuthorizedKey([]byte(strings.TrimSpace(rest)))
+		if err != nil {
+			diag.AddError(
+				"Invalid allowed signers",
+				fmt.Sprintf("Could not parse line %d of allowed signers file [%s] because of: %s", number+1, path, err.Error()),
+			)
+			return nil
+		}
+
+		signer := allowedSigner{principals: principals, key: key}
+		for _, option := range options {
+			if option == "cert-authority" {
+				tflog.Trace(ctx, "ignoring certificate authority", map[string]interface{}{
+					"principals": principals,
+				})
+				signer.key = nil
+			}
+			if namespaces, found := strings.CutPrefix(option, "namespaces="); found {
+				signer.namespaces = strings.Split(strings.Trim(namespaces, `"`), ",")
+			}
+		}
+		if signer.key != nil {
+			signers = append(signers, signer)
+		}
+	}
+
+	tflog.Trace(ctx, "read allowed signers", map[string]interface{}{
+		"path":    path,
+		"signers": len(signers),
+	})
+	return signers
+}
+
+// verifySSHSignature checks the armored SSH signature of the given object against a list of allowed signers and
+// returns the signer which created the signature.
+func verifySSHSignature(obj signableObject, signature string, signers []allowedSigner) (*allowedSigner, error) {
+	armored := strings.TrimSpace(signature)
+	armored = strings.TrimPrefix(armored, "-----BEGIN SSH SIGNATURE-----")
+	armored = strings.TrimSuffix(armored, "-----END SSH SIGNATURE-----")
+	blob, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(armored), ""))
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(blob, []byte(sshSignatureMagic)) {
+		return nil, errors.New("invalid SSH signature")
+	}
+
+	var envelope struct {
+		Version   uint32
+		PublicKey []byte
+		Namespace string
+		Reserved  string
+		Hash      string
+		Signature []byte
+	}
+	if err = ssh.Unmarshal(blob[len(sshSignatureMagic):], &envelope); err != nil {
+		return nil, err
+	}
+	if envelope.Namespace != sshSignatureNamespace {
+		return nil, fmt.Errorf("unexpected signature namespace %q", envelope.Namespace)
+	}
+
+	publicKey, err := ssh.ParsePublicKey(envelope.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var signer *allowedSigner
+	for index := range signers {
+		candidate := &signers[index]
+		if !bytes.Equal(candidate.key.Marshal(), publicKey.Marshal()) {
+			continue
+		}
+		if len(candidate.namespaces) > 0 && !slices.Contains(candidate.namespaces, sshSignatureNamespace) {
+			continue
+		}
+		signer = candidate
+		break
+	}
+	if signer == nil {
+		return nil, fmt.Errorf("no allowed signer for key %s", ssh.FingerprintSHA256(publicKey))
+	}
+
+	var digest hash.Hash
+	switch envelope.Hash {
+	case "sha256":
+		digest = sha256.New()
+	case "sha512":
+		digest = sha512.New()
+	default:
+		return nil, fmt.Errorf("unsupported hash algorithm %q", envelope.Hash)
+	}
+
+	encoded := &plumbing.MemoryObject{}
+	if err = obj.EncodeWithoutSignature(encoded); err != nil {
+		return nil, err
+	}
+	reader, err := encoded.Reader()
+	if err != nil {
+		return nil, err
+	}
+	if _, err = io.Copy(digest, reader); err != nil {
+		return nil, err
+	}
+
+	sshSignature := &ssh.Signature{}
+	if err = ssh.Unmarshal(envelope.Signature, sshSignature); err != nil {
+		return nil, err
+	}
+	if err = publicKey.Verify(sshSignedData(envelope.Hash, digest.Sum(nil)), sshSignature); err != nil {
+		return nil, err
+	}
+
+	return signer, nil
+}
@@ -0,0 +1,109 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func CreateSubmoduleUpdateOptions(ctx context.Context, inputs *SubmoduleResourceModel, defaults *GitProviderModel, diag *diag.Diagnostics) *git.SubmoduleUpdateOptions {
+	options := &git.SubmoduleUpdateOptions{}
+
+	options.Init = inputs.Init.ValueBool()
+	tflog.Trace(ctx, "using 'Init'", map[string]interface{}{
+		"Init": inputs.Init.ValueBool(),
+	})
+
+	options.NoFetch = inputs.NoFetch.ValueBool()
+	tflog.Trace(ctx, "using 'NoFetch'", map[string]interface{}{
+		"NoFetch": inputs.NoFetch.ValueBool(),
+	})
+
+	if inputs.Recursive.ValueBool() {
+		options.RecurseSubmodules = git.DefaultSubmoduleRecursionDepth
+		tflog.Trace(ctx, "using 'RecurseSubmodules'", map[string]interface{}{
+			"RecurseSubmodules": options.RecurseSubmodules,
+		})
+	}
+
+	options.Depth = int(inputs.Depth.ValueInt64())
+	tflog.Trace(ctx, "using 'Depth'", map[string]interface{}{
+		"Depth": inputs.Depth.ValueInt64(),
+	})
+
+	options.Auth = authOptions(ctx, inputs.Auth, defaults, diag)
+	if diag.HasError() {
+		return nil
+	}
+
+	return options
+}
+
+// getSubmodules returns the submodule with the given name or all submodules of the worktree in case no name is given.
+func getSubmodules(ctx context.Context, worktree *git.Worktree, name types.String, diag *diag.Diagnostics) git.Submodules {
+	if name.IsNull() || name.IsUnknown() {
+		submodules, err := worktree.Submodules()
+		if err != nil {
+			diag.AddError(
+				"Cannot read submodules",
+				"Could not read submodules because of: "+err.Error(),
+			)
+			return nil
+		}
+		tflog.Trace(ctx, "read submodules", map[string]interface{}{
+			"submodules": len(submodules),
+		})
+		return submodules
+	}
+
+	submodule, err := worktree.Submodule(name.ValueString())
+	if errors.Is(err, git.ErrSubmoduleNotFound) {
+		diag.AddError(
+			"Cannot find submodule",
+			"The submodule ["+name.ValueString()+"] is not declared in '.gitmodules'",
+		)
+		return nil
+	} else if err != nil {
+		diag.AddError(
+			"Cannot read submodules",
+			"Could not read submodule ["+name.ValueString()+"] because of: "+err.Error(),
+		)
+		return nil
+	}
+
+	tflog.Trace(ctx, "read submodule", map[string]interface{}{
+		"submodule": name.ValueString(),
+	})
+	return git.Submodules{submodule}
+}
+
+// getSubmoduleStatuses returns the status of each given submodule keyed by its name.
+func getSubmoduleStatuses(ctx context.Context, submodules git.Submodules, diag *diag.Diagnostics) map[string]*git.SubmoduleStatus {
+	statuses := make(map[string]*git.SubmoduleStatus)
+	for _, submodule := range submodules {
+		name := submodule.Config().Name
+		status, err := submodule.Status()
+		if err != nil {
+			diag.AddError(
+				"Cannot read submodule status",
+				"Could not read status of submodule ["+name+"] because of: "+err.Error(),
+			)
+			return nil
+		}
+		statuses[name] = status
+	}
+
+	tflog.Trace(ctx, "read submodule statuses", map[string]interface{}{
+		"submodules": len(statuses),
+	})
+	return statuses
+}
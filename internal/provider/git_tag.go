@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
@@ -16,13 +17,118 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-func createTagOptions(inputs tagResourceModel) *git.CreateTagOptions {
+func createTagOptions(ctx context.Context, inputs tagResourceModel, diag *diag.Diagnostics) *git.CreateTagOptions {
 	if inputs.Message.IsNull() || inputs.Message.IsUnknown() {
 		return nil
 	}
-	return &git.CreateTagOptions{
+
+	options := &git.CreateTagOptions{
 		Message: inputs.Message.ValueString(),
 	}
+
+	if !inputs.Tagger.IsNull() && !inputs.Tagger.IsUnknown() {
+		options.Tagger = objectToSignature(&inputs.Tagger)
+		tflog.Trace(ctx, "using 'Tagger'", map[string]interface{}{
+			"name":  options.Tagger.Name,
+			"email": options.Tagger.Email,
+		})
+	}
+
+	if !inputs.Signing.IsNull() && !inputs.Signing.IsUnknown() && signingFormat(inputs.Signing) != "ssh" {
+		options.SignKey = openpgpSigningKey(ctx, inputs.Signing, diag)
+		if diag.HasError() {
+			return nil
+		}
+	}
+
+	return options
+}
+
+func createTag(ctx context.Context, repository *git.Repository, tagName string, hash plumbing.Hash, inputs tagResourceModel, diag *diag.Diagnostics) *plumbing.Reference {
+	options := createTagOptions(ctx, inputs, diag)
+	if diag.HasError() {
+		return nil
+	}
+
+	var tag *plumbing.Reference
+	var err error
+	if options != nil && !inputs.Signing.IsNull() && !inputs.Signing.IsUnknown() && signingFormat(inputs.Signing) == "ssh" {
+		signer := sshSigningKey(ctx, inputs.Signing, diag)
+		if signer == nil {
+			return nil
+		}
+		tag, err = createSignedTag(repository, tagName, hash, options, signer)
+	} else {
+		tag, err = repository.CreateTag(tagName, hash, options)
+	}
+	if err != nil {
+		diag.AddError(
+			"Cannot create tag",
+			"Could not create tag ["+tagName+"] because of: "+err.Error(),
+		)
+		return nil
+	}
+
+	tflog.Trace(ctx, "created tag", map[string]interface{}{
+		"tag":  tagName,
+		"hash": tag.Hash().String(),
+	})
+	return tag
+}
+
+// createSignedTag creates an annotated tag signed by the given signer. go-git only supports OpenPGP keys when
+// creating tags, thus the tag object is assembled here in the same way as 'Repository.CreateTag' does.
+func createSignedTag(repository *git.Repository, tagName string, hash plumbing.Hash, options *git.CreateTagOptions, signer git.Signer) (*plumbing.Reference, error) {
+	referenceName := plumbing.NewTagReferenceName(tagName)
+	if _, err := repository.Storer.Reference(referenceName); err == nil {
+		return nil, git.ErrTagExists
+	}
+
+	if err := options.Validate(repository, hash); err != nil {
+		return nil, err
+	}
+
+	target, err := repository.Storer.EncodedObject(plumbing.AnyObject, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	tag := &object.Tag{
+		Name:       tagName,
+		Tagger:     *options.Tagger,
+		Message:    options.Message,
+		TargetType: target.Type(),
+		Target:     hash,
+	}
+
+	unsigned := &plumbing.MemoryObject{}
+	if err = tag.Encode(unsigned); err != nil {
+		return nil, err
+	}
+	reader, err := unsigned.Reader()
+	if err != nil {
+		return nil, err
+	}
+	signature, err := signer.Sign(reader)
+	if err != nil {
+		return nil, err
+	}
+	tag.PGPSignature = string(signature)
+
+	signed := repository.Storer.NewEncodedObject()
+	if err = tag.Encode(signed); err != nil {
+		return nil, err
+	}
+	tagHash, err := repository.Storer.SetEncodedObject(signed)
+	if err != nil {
+		return nil, err
+	}
+
+	reference := plumbing.NewHashReference(referenceName, tagHash)
+	if err = repository.Storer.SetReference(reference); err != nil {
+		return nil, err
+	}
+	return reference, nil
 }
 
 func getTagReference(ctx context.Context, repository *git.Repository, tagName string, diag *diag.Diagnostics) *plumbing.Reference {
@@ -60,3 +166,26 @@ func getTagObject(ctx context.Context, repository *git.Repository, hash plumbing
 		return nil, err
 	}
 }
+
+// verifyTagSignature checks whether the given tag is signed by one of the keys in the keyring. Lightweight tags
+// cannot carry a signature and are therefore never verified.
+func verifyTagSignature(ctx context.Context, tag *object.Tag, keyring openpgp.EntityList) bool {
+	if tag == nil {
+		return false
+	}
+
+	entity, err := verifyOpenPGPSignature(tag, tag.PGPSignature, keyring)
+	if err != nil {
+		tflog.Trace(ctx, "cannot verify tag", map[string]interface{}{
+			"tag":   tag.Name,
+			"error": err.Error(),
+		})
+		return false
+	}
+
+	tflog.Trace(ctx, "verified tag", map[string]interface{}{
+		"tag":    tag.Name,
+		"key_id": entity.PrimaryKey.KeyIdString(),
+	})
+	return true
+}
@@ -0,0 +1,115 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// treeListEntry is a single entry of a tree listing similar to the output of 'git ls-tree'. The path is relative to
+// the root of the repository.
+type treeListEntry struct {
+	path  string
+	entry object.TreeEntry
+}
+
+// subTree returns the tree at the given path or the tree itself in case the path is empty.
+func subTree(ctx context.Context, tree *object.Tree, name string, diag *diag.Diagnostics) *object.Tree {
+	if name == "" {
+		return tree
+	}
+	sub, err := tree.Tree(name)
+	if errors.Is(err, object.ErrDirectoryNotFound) {
+		diag.AddError(
+			"Cannot read tree",
+			"Could not find directory ["+name+"] in tree ["+tree.Hash.String()+"]",
+		)
+		return nil
+	} else if err != nil {
+		diag.AddError(
+			"Cannot read tree",
+			"Could not read directory ["+name+"] because of: "+err.Error(),
+		)
+		return nil
+	}
+	tflog.Trace(ctx, "read sub tree", map[string]interface{}{
+		"tree": tree.Hash.String(),
+		"path": name,
+		"hash": sub.Hash.String(),
+	})
+	return sub
+}
+
+// listTree returns the entries of the given tree. Nested trees are walked in case 'recursive' is enabled in which case
+// the trees themselves are listed as well. Submodules are never walked into.
+func listTree(ctx context.Context, tree *object.Tree, prefix string, recursive bool, diag *diag.Diagnostics) []treeListEntry {
+	walker := object.NewTreeWalker(tree, recursive, nil)
+	defer walker.Close()
+
+	entries := make([]treeListEntry, 0)
+	for {
+		name, entry, err := walker.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			diag.AddError(
+				"Cannot read tree",
+				"Could not walk tree ["+tree.Hash.String()+"] because of: "+err.Error(),
+			)
+			return nil
+		}
+		entries = append(entries, treeListEntry{
+			path:  path.Join(prefix, name),
+			entry: entry,
+		})
+	}
+
+	tflog.Trace(ctx, "listed tree", map[string]interface{}{
+		"tree":      tree.Hash.String(),
+		"recursive": recursive,
+		"entries":   len(entries),
+	})
+	return entries
+}
+
+// treeEntryType returns the type of the object the given entry points to as shown by 'git ls-tree'.
+func treeEntryType(entry object.TreeEntry) string {
+	switch entry.Mode {
+	case filemode.Dir:
+		return plumbing.TreeObject.String()
+	case filemode.Submodule:
+		return plumbing.CommitObject.String()
+	default:
+		return plumbing.BlobObject.String()
+	}
+}
+
+// treeEntrySize returns the size of the blob the given entry points to. Trees and submodules have no size.
+func treeEntrySize(repository *git.Repository, entry object.TreeEntry, diag *diag.Diagnostics) types.Int64 {
+	if !entry.Mode.IsFile() {
+		return types.Int64Null()
+	}
+	size, err := repository.Storer.EncodedObjectSize(entry.Hash)
+	if err != nil {
+		diag.AddError(
+			"Cannot read object",
+			"Could not read size of object ["+entry.Hash.String()+"] because of: "+err.Error(),
+		)
+		return types.Int64Null()
+	}
+	return types.Int64Value(size)
+}
@@ -0,0 +1,126 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/crypto/ssh"
+)
+
+type signedObject struct {
+	object    signableObject
+	kind      string
+	hash      plumbing.Hash
+	signature string
+}
+
+type verification struct {
+	verified bool
+	keyID    string
+	identity string
+	reason   string
+}
+
+// getSignedObject returns the annotated tag with the given name or the commit the given revision resolves to.
+func getSignedObject(ctx context.Context, repository *git.Repository, revision string, diag *diag.Diagnostics) *signedObject {
+	if tagReference, err := repository.Tag(strings.TrimPrefix(revision, "refs/tags/")); err == nil {
+		tagObject, err := getTagObject(ctx, repository, tagReference.Hash(), diag)
+		if err != nil {
+			return nil
+		}
+		if tagObject != nil {
+			return &signedObject{
+				object:    tagObject,
+				kind:      "tag",
+				hash:      tagObject.Hash,
+				signature: tagObject.PGPSignature,
+			}
+		}
+	}
+
+	hash := resolveRevision(ctx, repository, revision, diag)
+	if hash == nil {
+		return nil
+	}
+
+	commitObject := getCommit(ctx, repository, hash, diag)
+	if commitObject == nil {
+		return nil
+	}
+
+	return &signedObject{
+		object:    commitObject,
+		kind:      "commit",
+		hash:      commitObject.Hash,
+		signature: commitObject.PGPSignature,
+	}
+}
+
+func verifySignedObject(ctx context.Context, signed *signedObject, keyring openpgp.EntityList, signers []allowedSigner) verification {
+	var result verification
+	switch {
+	case signed.signature == "":
+		result.reason = "object is not signed"
+	case strings.HasPrefix(signed.signature, "-----BEGIN SSH SIGNATURE-----"):
+		result = verifySSHSignedObject(signed, signers)
+	case strings.HasPrefix(signed.signature, "-----BEGIN PGP SIGNATURE-----"):
+		result = verifyOpenPGPSignedObject(signed, keyring)
+	default:
+		result.reason = "unsupported signature format"
+	}
+
+	tflog.Trace(ctx, "verified signature", map[string]interface{}{
+		"hash":     signed.hash.String(),
+		"verified": result.verified,
+		"key_id":   result.keyID,
+		"reason":   result.reason,
+	})
+	return result
+}
+
+func verifyOpenPGPSignedObject(signed *signedObject, keyring openpgp.EntityList) verification {
+	if len(keyring) == 0 {
+		return verification{reason: "OpenPGP signature cannot be verified without public keys"}
+	}
+
+	entity, err := verifyOpenPGPSignature(signed.object, signed.signature, keyring)
+	if err != nil {
+		return verification{reason: err.Error()}
+	}
+
+	result := verification{
+		verified: true,
+		keyID:    entity.PrimaryKey.KeyIdString(),
+	}
+	if identity := entity.PrimaryIdentity(); identity != nil {
+		result.identity = identity.Name
+	}
+	return result
+}
+
+func verifySSHSignedObject(signed *signedObject, signers []allowedSigner) verification {
+	if signers == nil {
+		return verification{reason: "SSH signature cannot be verified without allowed signers"}
+	}
+
+	signer, err := verifySSHSignature(signed.object, signed.signature, signers)
+	if err != nil {
+		return verification{reason: err.Error()}
+	}
+
+	return verification{
+		verified: true,
+		keyID:    ssh.FingerprintSHA256(signer.key),
+		identity: signer.principals,
+	}
+}
@@ -8,9 +8,15 @@ package provider
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 
+	"github.com/go-git/go-billy/v5/util"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/storage/filesystem"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -55,3 +61,394 @@ func getStatus(ctx context.Context, worktree *git.Worktree, diag *diag.Diagnosti
 	})
 	return status
 }
+
+// linkedWorktree describes a worktree attached to a repository similar to the output of 'git worktree list'. The main
+// worktree has an empty name.
+type linkedWorktree struct {
+	path       string
+	name       string
+	head       *plumbing.Reference
+	bare       bool
+	locked     bool
+	lockReason string
+	prunable   bool
+}
+
+// commonDirectory returns the path to the Git directory which is shared by all worktrees of the given repository.
+func commonDirectory(repository *git.Repository, diag *diag.Diagnostics) string {
+	storage, ok := repository.Storer.(*filesystem.Storage)
+	if !ok {
+		diag.AddError(
+			"Cannot find Git directory",
+			"The repository is not stored on the filesystem.",
+		)
+		return ""
+	}
+	fs := storage.Filesystem()
+	content, err := util.ReadFile(fs, "commondir")
+	if errors.Is(err, os.ErrNotExist) {
+		return fs.Root()
+	} else if err != nil {
+		diag.AddError(
+			"Cannot find Git directory",
+			"Could not read 'commondir' of ["+fs.Root()+"] because of: "+err.Error(),
+		)
+		return ""
+	}
+	common := strings.TrimSpace(string(content))
+	if !filepath.IsAbs(common) {
+		common = filepath.Join(fs.Root(), common)
+	}
+	return filepath.Clean(common)
+}
+
+// readWorktreeHead reads the 'HEAD' file of a worktree which is either a symbolic reference or a detached commit.
+func readWorktreeHead(file string) (*plumbing.Reference, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	line := strings.TrimSpace(string(content))
+	if target, ok := strings.CutPrefix(line, "ref: "); ok {
+		return plumbing.NewSymbolicReference(plumbing.HEAD, plumbing.ReferenceName(target)), nil
+	}
+	return plumbing.NewHashReference(plumbing.HEAD, plumbing.NewHash(line)), nil
+}
+
+// listWorktrees returns the main worktree followed by all linked worktrees of the given repository.
+func listWorktrees(ctx context.Context, repository *git.Repository, diag *diag.Diagnostics) []linkedWorktree {
+	common := commonDirectory(repository, diag)
+	if common == "" {
+		return nil
+	}
+
+	cfg, err := repository.Config()
+	if err != nil {
+		diag.AddError(
+			"Cannot read config",
+			"Could not read config of ["+common+"] because of: "+err.Error(),
+		)
+		return nil
+	}
+
+	main := linkedWorktree{path: common, bare: cfg.Core.IsBare}
+	if !main.bare && filepath.Base(common) == git.GitDirName {
+		main.path = filepath.Dir(common)
+	}
+	main.head, err = readWorktreeHead(filepath.Join(common, "HEAD"))
+	if err != nil {
+		diag.AddError(
+			"Cannot read HEAD",
+			"Could not read HEAD of ["+main.path+"] because of: "+err.Error(),
+		)
+		return nil
+	}
+	worktrees := []linkedWorktree{main}
+
+	entries, err := os.ReadDir(filepath.Join(common, "worktrees"))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		diag.AddError(
+			"Cannot read worktrees",
+			"Could not read worktrees of ["+common+"] because of: "+err.Error(),
+		)
+		return nil
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		admin := filepath.Join(common, "worktrees", entry.Name())
+		worktree := linkedWorktree{name: entry.Name()}
+		if gitdir, errGitdir := os.ReadFile(filepath.Join(admin, "gitdir")); errGitdir == nil {
+			dotGit := strings.TrimSpace(string(gitdir))
+			worktree.path = filepath.Dir(dotGit)
+			if _, errStat := os.Stat(dotGit); errStat != nil {
+				worktree.prunable = true
+			}
+		} else {
+			worktree.prunable = true
+		}
+		if reason, errLocked := os.ReadFile(filepath.Join(admin, "locked")); errLocked == nil {
+			worktree.locked = true
+			worktree.lockReason = strings.TrimSpace(string(reason))
+		}
+		worktree.head, err = readWorktreeHead(filepath.Join(admin, "HEAD"))
+		if err != nil {
+			diag.AddError(
+				"Cannot read HEAD",
+				"Could not read HEAD of worktree ["+entry.Name()+"] because of: "+err.Error(),
+			)
+			return nil
+		}
+		worktrees = append(worktrees, worktree)
+	}
+
+	tflog.Trace(ctx, "read worktrees", map[string]interface{}{
+		"common":    common,
+		"worktrees": len(worktrees),
+	})
+	return worktrees
+}
+
+// resolveWorktreeHead returns the commit the 'HEAD' of a worktree points to or nil in case its branch has no commits.
+func resolveWorktreeHead(repository *git.Repository, head *plumbing.Reference) *plumbing.Hash {
+	if head.Type() == plumbing.HashReference {
+		hash := head.Hash()
+		return &hash
+	}
+	reference, err := repository.Reference(head.Target(), true)
+	if err != nil {
+		return nil
+	}
+	hash := reference.Hash()
+	return &hash
+}
+
+// findWorktree returns the worktree which has the given branch checked out or nil if there is none.
+func findWorktree(worktrees []linkedWorktree, branch plumbing.ReferenceName) *linkedWorktree {
+	for i := range worktrees {
+		head := worktrees[i].head
+		if !worktrees[i].bare && head.Type() == plumbing.SymbolicReference && head.Target() == branch {
+			return &worktrees[i]
+		}
+	}
+	return nil
+}
+
+// findWorktreeByName returns the linked worktree with the given name or nil if there is none.
+func findWorktreeByName(ctx context.Context, repository *git.Repository, name string, diag *diag.Diagnostics) *linkedWorktree {
+	worktrees := listWorktrees(ctx, repository, diag)
+	for i := range worktrees {
+		if worktrees[i].name != "" && worktrees[i].name == name {
+			return &worktrees[i]
+		}
+	}
+	return nil
+}
+
+// worktreeName returns an unused name for the administrative files of a new linked worktree at the given path.
+func worktreeName(common string, path string) string {
+	base := strings.Map(func(r rune) rune {
+		if r == ' ' || r == '/' || r == '\\' || r == ':' {
+			return '-'
+		}
+		return r
+	}, filepath.Base(path))
+	name := base
+	for i := 1; ; i++ {
+		if _, err := os.Stat(filepath.Join(common, "worktrees", name)); errors.Is(err, os.ErrNotExist) {
+			return name
+		}
+		name = base + strconv.Itoa(i)
+	}
+}
+
+// addWorktree creates a new linked worktree at the given path similar to 'git worktree add'. The worktree checks out
+// the given branch or the given commit in detached mode in case no branch is specified. Returns the name of the
+// administrative directory of the worktree.
+func addWorktree(ctx context.Context, repository *git.Repository, path string, branch plumbing.ReferenceName, hash plumbing.Hash, diag *diag.Diagnostics) string {
+	common := commonDirectory(repository, diag)
+	if common == "" {
+		return ""
+	}
+
+	path, err := filepath.Abs(path)
+	if err != nil {
+		diag.AddError(
+			"Cannot create worktree",
+			"Could not determine absolute path of worktree ["+path+"] because of: "+err.Error(),
+		)
+		return ""
+	}
+	if entries, errRead := os.ReadDir(path); errRead == nil && len(entries) > 0 {
+		diag.AddError(
+			"Cannot create worktree",
+			"The directory ["+path+"] already exists and is not empty.",
+		)
+		return ""
+	}
+
+	worktrees := listWorktrees(ctx, repository, diag)
+	if diag.HasError() {
+		return ""
+	}
+	for _, existing := range worktrees {
+		if existing.name != "" && existing.prunable && existing.path == path {
+			// the directory of a previous worktree was deleted, thus its stale administrative files can be replaced
+			if err = os.RemoveAll(filepath.Join(common, "worktrees", existing.name)); err != nil {
+				diag.AddError(
+					"Cannot create worktree",
+					"Could not prune stale worktree ["+existing.name+"] because of: "+err.Error(),
+				)
+				return ""
+			}
+		}
+	}
+
+	name := worktreeName(common, path)
+	admin := filepath.Join(common, "worktrees", name)
+	head := hash.String()
+	if branch != "" {
+		head = "ref: " + branch.String()
+	}
+	files := []struct {
+		name    string
+		content string
+	}{
+		{filepath.Join(admin, "commondir"), "../..\n"},
+		{filepath.Join(admin, "gitdir"), filepath.Join(path, git.GitDirName) + "\n"},
+		{filepath.Join(admin, "HEAD"), head + "\n"},
+		{filepath.Join(path, git.GitDirName), "gitdir: " + admin + "\n"},
+	}
+	_, err = os.Lstat(path)
+	created := errors.Is(err, os.ErrNotExist)
+	added := false
+	defer func() {
+		if !added {
+			discardWorktree(admin, path, created, diag)
+		}
+	}()
+	for _, dir := range []string{admin, path} {
+		if err = os.MkdirAll(dir, 0755); err != nil {
+			diag.AddError(
+				"Cannot create worktree",
+				"Could not create directory ["+dir+"] because of: "+err.Error(),
+			)
+			return ""
+		}
+	}
+	for _, file := range files {
+		if err = os.WriteFile(file.name, []byte(file.content), 0644); err != nil {
+			diag.AddError(
+				"Cannot create worktree",
+				"Could not write file ["+file.name+"] because of: "+err.Error(),
+			)
+			return ""
+		}
+	}
+
+	linked := openRepository(ctx, path, diag)
+	if linked == nil {
+		return ""
+	}
+	worktree, err := getWorktree(linked, diag)
+	if err != nil {
+		return ""
+	}
+	err = worktree.Reset(&git.ResetOptions{Commit: hash, Mode: git.HardReset})
+	if err != nil {
+		diag.AddError(
+			"Cannot create worktree",
+			"Could not check out ["+hash.String()+"] in worktree ["+path+"] because of: "+err.Error(),
+		)
+		return ""
+	}
+
+	added = true
+	tflog.Trace(ctx, "added worktree", map[string]interface{}{
+		"path":   path,
+		"name":   name,
+		"branch": branch.String(),
+		"hash":   hash.String(),
+	})
+	return name
+}
+
+// discardWorktree removes the administrative files and the directory of a worktree which could not be added. A directory
+// which existed before is kept and only emptied again, since addWorktree requires it to be empty.
+func discardWorktree(admin string, path string, created bool, diag *diag.Diagnostics) {
+	removals := []string{admin}
+	if created {
+		removals = append(removals, path)
+	} else if entries, err := os.ReadDir(path); err == nil {
+		for _, entry := range entries {
+			removals = append(removals, filepath.Join(path, entry.Name()))
+		}
+	}
+	for _, removal := range removals {
+		if err := os.RemoveAll(removal); err != nil {
+			diag.AddWarning(
+				"Cannot clean up worktree",
+				"Could not remove ["+removal+"] because of: "+err.Error(),
+			)
+		}
+	}
+}
+
+// lockWorktree locks or unlocks the linked worktree with the given name similar to 'git worktree lock'.
+func lockWorktree(ctx context.Context, common string, name string, locked bool, reason string, diag *diag.Diagnostics) {
+	file := filepath.Join(common, "worktrees", name, "locked")
+	var err error
+	if locked {
+		err = os.WriteFile(file, []byte(reason), 0644)
+	} else if err = os.Remove(file); errors.Is(err, os.ErrNotExist) {
+		err = nil
+	}
+	if err != nil {
+		diag.AddError(
+			"Cannot lock worktree",
+			"Could not change lock of worktree ["+name+"] because of: "+err.Error(),
+		)
+		return
+	}
+	tflog.Trace(ctx, "locked worktree", map[string]interface{}{
+		"name":   name,
+		"locked": locked,
+	})
+}
+
+// removeWorktree deletes the linked worktree at the given path along with its administrative files similar to
+// 'git worktree remove'. The directory at the given path is only deleted in case its '.git' file still points to the
+// administrative files of the worktree, thus directories which were replaced by hand are kept.
+func removeWorktree(ctx context.Context, common string, name string, path string, diag *diag.Diagnostics) {
+	admin := filepath.Join(common, "worktrees", name)
+	dirs := []string{admin}
+	if isLinkedWorktree(path, admin) {
+		dirs = append(dirs, path)
+	} else if _, err := os.Lstat(path); err == nil {
+		diag.AddWarning(
+			"Worktree directory kept",
+			"The directory ["+path+"] is not linked to the worktree ["+name+"] anymore, thus only the administrative files of the worktree are removed.",
+		)
+	}
+
+	for _, dir := range dirs {
+		if err := os.RemoveAll(dir); err != nil {
+			diag.AddError(
+				"Cannot remove worktree",
+				"Could not remove directory ["+dir+"] because of: "+err.Error(),
+			)
+			return
+		}
+	}
+	tflog.Trace(ctx, "removed worktree", map[string]interface{}{
+		"name":    name,
+		"path":    path,
+		"removed": len(dirs),
+	})
+}
+
+// isLinkedWorktree returns true if the '.git' file at the given path points to the given administrative directory.
+func isLinkedWorktree(path string, admin string) bool {
+	content, err := os.ReadFile(filepath.Join(path, git.GitDirName))
+	if err != nil {
+		return false
+	}
+	gitdir, ok := strings.CutPrefix(strings.TrimSpace(string(content)), "gitdir:")
+	if !ok {
+		return false
+	}
+	gitdir = strings.TrimSpace(gitdir)
+	if !filepath.IsAbs(gitdir) {
+		gitdir = filepath.Join(path, gitdir)
+	}
+	linked, err := os.Stat(gitdir)
+	if err != nil {
+		return false
+	}
+	expected, err := os.Stat(admin)
+	if err != nil {
+		return false
+	}
+	return os.SameFile(linked, expected)
+}
@@ -0,0 +1,150 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/metio/terraform-provider-git/internal/provider"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateCloneOptions_EmptyModel(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.CloneResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	options := provider.CreateCloneOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.Empty(t, options.URL)
+	assert.Empty(t, options.RemoteName)
+	assert.Equal(t, 0, options.Depth)
+	assert.False(t, options.SingleBranch)
+	assert.False(t, options.NoCheckout)
+	assert.Equal(t, git.InvalidTagMode, options.Tags)
+	assert.Equal(t, git.NoRecurseSubmodules, options.RecurseSubmodules)
+	assert.False(t, options.ShallowSubmodules)
+	assert.Nil(t, options.Auth)
+}
+
+func TestCreateCloneOptions_ReferenceName(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.CloneResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.ReferenceName = types.StringValue("refs/heads/main")
+	options := provider.CreateCloneOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.Equal(t, plumbing.ReferenceName("refs/heads/main"), options.ReferenceName)
+}
+
+func TestCreateCloneOptions_Depth(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.CloneResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.Depth = types.Int64Value(1)
+	options := provider.CreateCloneOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.Equal(t, 1, options.Depth)
+}
+
+func TestCreateCloneOptions_SingleBranch(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.CloneResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.SingleBranch = types.BoolValue(true)
+	options := provider.CreateCloneOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.True(t, options.SingleBranch)
+}
+
+func TestCreateCloneOptions_NoCheckout(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.CloneResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.NoCheckout = types.BoolValue(true)
+	options := provider.CreateCloneOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.True(t, options.NoCheckout)
+}
+
+func TestCreateCloneOptions_Tags(t *testing.T) {
+	ctx := context.TODO()
+
+	testCases := map[string]git.TagMode{
+		"all":       git.AllTags,
+		"none":      git.NoTags,
+		"following": git.TagFollowing,
+	}
+
+	for value, expected := range testCases {
+		t.Run(value, func(t *testing.T) {
+			model := &provider.CloneResourceModel{}
+			diagnostics := &diag.Diagnostics{}
+
+			model.Auth = types.ObjectNull(map[string]attr.Type{})
+			model.Tags = types.StringValue(value)
+			options := provider.CreateCloneOptions(ctx, model, nil, diagnostics)
+
+			assert.NotNil(t, options)
+			assert.False(t, diagnostics.HasError())
+			assert.Equal(t, expected, options.Tags)
+		})
+	}
+}
+
+func TestCreateCloneOptions_RecurseSubmodules(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.CloneResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.RecurseSubmodules = types.BoolValue(true)
+	model.ShallowSubmodules = types.BoolValue(true)
+	options := provider.CreateCloneOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.Equal(t, git.DefaultSubmoduleRecursionDepth, options.RecurseSubmodules)
+	assert.True(t, options.ShallowSubmodules)
+}
+
+func TestCreateCloneOptions_InsecureSkipTLS(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.CloneResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.InsecureSkipTls = types.BoolValue(true)
+	options := provider.CreateCloneOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.True(t, options.InsecureSkipTLS)
+}
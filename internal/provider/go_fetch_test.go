@@ -0,0 +1,143 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/metio/terraform-provider-git/internal/provider"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateFetchOptions_EmptyModel(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.FetchResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	options := provider.CreateFetchOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.Empty(t, options.RefSpecs)
+	assert.Empty(t, options.RemoteName)
+	assert.Equal(t, 0, options.Depth)
+	assert.False(t, options.Prune)
+	assert.False(t, options.Force)
+	assert.Equal(t, git.TagFollowing, options.Tags)
+	assert.Nil(t, options.Auth)
+}
+
+func TestCreateFetchOptions_RefSpecs(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.FetchResourceModel{}
+	var diagnostics diag.Diagnostics
+
+	model.RefSpecs, diagnostics = types.ListValueFrom(ctx, types.StringType, []string{"+refs/heads/main:refs/remotes/origin/main"})
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	options := provider.CreateFetchOptions(ctx, model, nil, &diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.Equal(t, 1, len(options.RefSpecs))
+	assert.Equal(t, "+refs/heads/main:refs/remotes/origin/main", options.RefSpecs[0].String())
+}
+
+func TestCreateFetchOptions_Remote(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.FetchResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.Remote = types.StringValue("upstream")
+	options := provider.CreateFetchOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.Equal(t, "upstream", options.RemoteName)
+}
+
+func TestCreateFetchOptions_Depth(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.FetchResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.Depth = types.Int64Value(1)
+	options := provider.CreateFetchOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.Equal(t, 1, options.Depth)
+}
+
+func TestCreateFetchOptions_Prune(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.FetchResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.Prune = types.BoolValue(true)
+	options := provider.CreateFetchOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.True(t, options.Prune)
+}
+
+func TestCreateFetchOptions_Force(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.FetchResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.Force = types.BoolValue(true)
+	options := provider.CreateFetchOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.True(t, options.Force)
+}
+
+func TestCreateFetchOptions_Tags(t *testing.T) {
+	ctx := context.TODO()
+	testCases := map[string]git.TagMode{
+		"all":       git.AllTags,
+		"none":      git.NoTags,
+		"following": git.TagFollowing,
+	}
+	for mode, expected := range testCases {
+		t.Run(mode, func(t *testing.T) {
+			model := &provider.FetchResourceModel{}
+			diagnostics := &diag.Diagnostics{}
+
+			model.Auth = types.ObjectNull(map[string]attr.Type{})
+			model.Tags = types.StringValue(mode)
+			options := provider.CreateFetchOptions(ctx, model, nil, diagnostics)
+
+			assert.NotNil(t, options)
+			assert.False(t, diagnostics.HasError())
+			assert.Equal(t, expected, options.Tags)
+		})
+	}
+}
+
+func TestCreateFetchOptions_CaBundleFilePath_Invalid(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.FetchResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.CaBundleFilePath = types.StringValue("/does/not/exist")
+	options := provider.CreateFetchOptions(ctx, model, nil, diagnostics)
+
+	assert.Nil(t, options)
+	assert.True(t, diagnostics.HasError())
+}
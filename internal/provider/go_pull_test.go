@@ -0,0 +1,118 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/metio/terraform-provider-git/internal/provider"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatePullOptions_EmptyModel(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.PullResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	options := provider.CreatePullOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.Empty(t, options.RemoteName)
+	assert.Empty(t, options.ReferenceName)
+	assert.False(t, options.SingleBranch)
+	assert.Equal(t, 0, options.Depth)
+	assert.False(t, options.Force)
+	assert.Nil(t, options.Auth)
+}
+
+func TestCreatePullOptions_RemoteName(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.PullResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.RemoteName = types.StringValue("upstream")
+	options := provider.CreatePullOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.Equal(t, "upstream", options.RemoteName)
+}
+
+func TestCreatePullOptions_ReferenceName_Short(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.PullResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.ReferenceName = types.StringValue("main")
+	options := provider.CreatePullOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.Equal(t, "refs/heads/main", options.ReferenceName.String())
+}
+
+func TestCreatePullOptions_ReferenceName_FullyQualified(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.PullResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.ReferenceName = types.StringValue("refs/heads/release/v1")
+	options := provider.CreatePullOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.Equal(t, "refs/heads/release/v1", options.ReferenceName.String())
+}
+
+func TestCreatePullOptions_SingleBranch(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.PullResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.SingleBranch = types.BoolValue(true)
+	options := provider.CreatePullOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.True(t, options.SingleBranch)
+}
+
+func TestCreatePullOptions_Depth(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.PullResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.Depth = types.Int64Value(5)
+	options := provider.CreatePullOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.Equal(t, 5, options.Depth)
+}
+
+func TestCreatePullOptions_Force(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.PullResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.Force = types.BoolValue(true)
+	options := provider.CreatePullOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.True(t, options.Force)
+}
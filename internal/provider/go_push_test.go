@@ -9,6 +9,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -21,7 +22,7 @@ func TestCreatePushOptions_EmptyModel(t *testing.T) {
 	model := &provider.PushResourceModel{}
 	diagnostics := &diag.Diagnostics{}
 
-	options := provider.CreatePushOptions(ctx, model, diagnostics)
+	options := provider.CreatePushOptions(ctx, model, nil, diagnostics)
 
 	assert.Nil(t, options)
 	assert.False(t, diagnostics.HasError())
@@ -34,7 +35,7 @@ func TestCreatePushOptions_RefSpecs(t *testing.T) {
 
 	model.RefSpecs, diagnostics = types.ListValueFrom(ctx, types.StringType, []string{"refs/heads/main"})
 	model.Auth = types.ObjectNull(map[string]attr.Type{})
-	options := provider.CreatePushOptions(ctx, model, &diagnostics)
+	options := provider.CreatePushOptions(ctx, model, nil, &diagnostics)
 
 	assert.NotNil(t, options)
 	assert.False(t, diagnostics.HasError())
@@ -54,7 +55,7 @@ func TestCreatePushOptions_Remote(t *testing.T) {
 	model.RefSpecs, _ = types.ListValueFrom(ctx, types.StringType, []string{"refs/heads/main"})
 	model.Auth = types.ObjectNull(map[string]attr.Type{})
 	model.Remote = types.StringValue("origin")
-	options := provider.CreatePushOptions(ctx, model, diagnostics)
+	options := provider.CreatePushOptions(ctx, model, nil, diagnostics)
 
 	assert.NotNil(t, options)
 	assert.False(t, diagnostics.HasError())
@@ -74,7 +75,7 @@ func TestCreatePushOptions_Prune(t *testing.T) {
 	model.RefSpecs, _ = types.ListValueFrom(ctx, types.StringType, []string{"refs/heads/main"})
 	model.Auth = types.ObjectNull(map[string]attr.Type{})
 	model.Prune = types.BoolValue(true)
-	options := provider.CreatePushOptions(ctx, model, diagnostics)
+	options := provider.CreatePushOptions(ctx, model, nil, diagnostics)
 
 	assert.NotNil(t, options)
 	assert.False(t, diagnostics.HasError())
@@ -94,7 +95,7 @@ func TestCreatePushOptions_Force(t *testing.T) {
 	model.RefSpecs, _ = types.ListValueFrom(ctx, types.StringType, []string{"refs/heads/main"})
 	model.Auth = types.ObjectNull(map[string]attr.Type{})
 	model.Force = types.BoolValue(true)
-	options := provider.CreatePushOptions(ctx, model, diagnostics)
+	options := provider.CreatePushOptions(ctx, model, nil, diagnostics)
 
 	assert.NotNil(t, options)
 	assert.False(t, diagnostics.HasError())
@@ -106,6 +107,107 @@ func TestCreatePushOptions_Force(t *testing.T) {
 	assert.Nil(t, options.Auth)
 }
 
+func TestCreatePushOptions_Atomic(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.PushResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.RefSpecs, _ = types.ListValueFrom(ctx, types.StringType, []string{"refs/heads/main"})
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.Atomic = types.BoolValue(true)
+	options := provider.CreatePushOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.True(t, options.Atomic)
+	assert.False(t, options.FollowTags)
+	assert.Nil(t, options.ForceWithLease)
+	assert.Nil(t, options.Options)
+}
+
+func TestCreatePushOptions_FollowTags(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.PushResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.RefSpecs, _ = types.ListValueFrom(ctx, types.StringType, []string{"refs/heads/main"})
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.FollowTags = types.BoolValue(true)
+	options := provider.CreatePushOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.False(t, options.Atomic)
+	assert.True(t, options.FollowTags)
+}
+
+func TestCreatePushOptions_ForceWithLease(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.PushResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.RefSpecs, _ = types.ListValueFrom(ctx, types.StringType, []string{"refs/heads/main"})
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.ForceWithLease = types.ObjectValueMust(
+		map[string]attr.Type{
+			"ref_name": types.StringType,
+			"sha1":     types.StringType,
+		},
+		map[string]attr.Value{
+			"ref_name": types.StringValue("refs/heads/main"),
+			"sha1":     types.StringValue("b1af8d13f5131c9b4de9ddd06e311c2e79fdb285"),
+		},
+	)
+	options := provider.CreatePushOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.NotNil(t, options.ForceWithLease)
+	assert.Equal(t, "refs/heads/main", options.ForceWithLease.RefName.String())
+	assert.Equal(t, "b1af8d13f5131c9b4de9ddd06e311c2e79fdb285", options.ForceWithLease.Hash.String())
+}
+
+func TestCreatePushOptions_ForceWithLease_Empty(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.PushResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.RefSpecs, _ = types.ListValueFrom(ctx, types.StringType, []string{"refs/heads/main"})
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.ForceWithLease = types.ObjectValueMust(
+		map[string]attr.Type{
+			"ref_name": types.StringType,
+			"sha1":     types.StringType,
+		},
+		map[string]attr.Value{
+			"ref_name": types.StringNull(),
+			"sha1":     types.StringNull(),
+		},
+	)
+	options := provider.CreatePushOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.NotNil(t, options.ForceWithLease)
+	assert.Empty(t, options.ForceWithLease.RefName)
+	assert.True(t, options.ForceWithLease.Hash.IsZero())
+}
+
+func TestCreatePushOptions_Options(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.PushResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.RefSpecs, _ = types.ListValueFrom(ctx, types.StringType, []string{"refs/heads/main"})
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.Options, _ = types.MapValueFrom(ctx, types.StringType, map[string]string{"ci.skip": "true"})
+	options := provider.CreatePushOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.Equal(t, map[string]string{"ci.skip": "true"}, options.Options)
+}
+
 func TestCreatePushOptions_Auth_Empty(t *testing.T) {
 	ctx := context.TODO()
 	model := &provider.PushResourceModel{}
@@ -113,7 +215,7 @@ func TestCreatePushOptions_Auth_Empty(t *testing.T) {
 
 	model.RefSpecs, _ = types.ListValueFrom(ctx, types.StringType, []string{"refs/heads/main"})
 	model.Auth = types.ObjectNull(map[string]attr.Type{})
-	options := provider.CreatePushOptions(ctx, model, diagnostics)
+	options := provider.CreatePushOptions(ctx, model, nil, diagnostics)
 
 	assert.NotNil(t, options)
 	assert.False(t, diagnostics.HasError())
@@ -139,7 +241,7 @@ func TestCreatePushOptions_Auth_BearerAuth_Null(t *testing.T) {
 			"bearer": types.StringNull(),
 		},
 	)
-	options := provider.CreatePushOptions(ctx, model, diagnostics)
+	options := provider.CreatePushOptions(ctx, model, nil, diagnostics)
 
 	assert.NotNil(t, options)
 	assert.False(t, diagnostics.HasError())
@@ -165,7 +267,7 @@ func TestCreatePushOptions_Auth_BearerAuth_Token(t *testing.T) {
 			"bearer": types.StringValue("secret-token"),
 		},
 	)
-	options := provider.CreatePushOptions(ctx, model, diagnostics)
+	options := provider.CreatePushOptions(ctx, model, nil, diagnostics)
 
 	assert.NotNil(t, options)
 	assert.False(t, diagnostics.HasError())
@@ -199,7 +301,7 @@ func TestCreatePushOptions_Auth_BasicAuth_Null(t *testing.T) {
 			}),
 		},
 	)
-	options := provider.CreatePushOptions(ctx, model, diagnostics)
+	options := provider.CreatePushOptions(ctx, model, nil, diagnostics)
 
 	assert.NotNil(t, options)
 	assert.False(t, diagnostics.HasError())
@@ -239,7 +341,7 @@ func TestCreatePushOptions_Auth_BasicAuth_Valid(t *testing.T) {
 			),
 		},
 	)
-	options := provider.CreatePushOptions(ctx, model, diagnostics)
+	options := provider.CreatePushOptions(ctx, model, nil, diagnostics)
 
 	assert.NotNil(t, options)
 	assert.False(t, diagnostics.HasError())
@@ -279,7 +381,7 @@ func TestCreatePushOptions_Auth_SshKeyAuth_Null(t *testing.T) {
 			}),
 		},
 	)
-	options := provider.CreatePushOptions(ctx, model, diagnostics)
+	options := provider.CreatePushOptions(ctx, model, nil, diagnostics)
 
 	assert.NotNil(t, options)
 	assert.False(t, diagnostics.HasError())
@@ -328,7 +430,7 @@ func TestCreatePushOptions_Auth_SshKeyAuth_NoPrivateKey(t *testing.T) {
 			),
 		},
 	)
-	options := provider.CreatePushOptions(ctx, model, diagnostics)
+	options := provider.CreatePushOptions(ctx, model, nil, diagnostics)
 
 	assert.Nil(t, options)
 	assert.True(t, diagnostics.HasError())
@@ -371,7 +473,7 @@ func TestCreatePushOptions_Auth_SshKeyAuth_PrivateKeyPath_Invalid(t *testing.T)
 			),
 		},
 	)
-	options := provider.CreatePushOptions(ctx, model, diagnostics)
+	options := provider.CreatePushOptions(ctx, model, nil, diagnostics)
 
 	assert.Nil(t, options)
 	assert.True(t, diagnostics.HasError())
@@ -399,7 +501,7 @@ func TestCreatePushOptions_Auth_SshAgentAuth_Null(t *testing.T) {
 			}),
 		},
 	)
-	options := provider.CreatePushOptions(ctx, model, diagnostics)
+	options := provider.CreatePushOptions(ctx, model, nil, diagnostics)
 
 	assert.NotNil(t, options)
 	assert.False(t, diagnostics.HasError())
@@ -435,7 +537,7 @@ func TestCreatePushOptions_Auth_SshPasswordAuth_Null(t *testing.T) {
 			}),
 		},
 	)
-	options := provider.CreatePushOptions(ctx, model, diagnostics)
+	options := provider.CreatePushOptions(ctx, model, nil, diagnostics)
 
 	assert.NotNil(t, options)
 	assert.False(t, diagnostics.HasError())
@@ -478,7 +580,7 @@ func TestCreatePushOptions_Auth_SshPasswordAuth_Valid(t *testing.T) {
 			),
 		},
 	)
-	options := provider.CreatePushOptions(ctx, model, diagnostics)
+	options := provider.CreatePushOptions(ctx, model, nil, diagnostics)
 
 	assert.NotNil(t, options)
 	assert.False(t, diagnostics.HasError())
@@ -522,7 +624,7 @@ func TestCreatePushOptions_Auth_SshPasswordAuth_KnownHosts_Valid(t *testing.T) {
 			),
 		},
 	)
-	options := provider.CreatePushOptions(ctx, model, diagnostics)
+	options := provider.CreatePushOptions(ctx, model, nil, diagnostics)
 
 	assert.NotNil(t, options)
 	assert.False(t, diagnostics.HasError())
@@ -565,7 +667,7 @@ func TestCreatePushOptions_Auth_SshPasswordAuth_KnownHosts_Null(t *testing.T) {
 			),
 		},
 	)
-	options := provider.CreatePushOptions(ctx, model, diagnostics)
+	options := provider.CreatePushOptions(ctx, model, nil, diagnostics)
 
 	assert.NotNil(t, options)
 	assert.False(t, diagnostics.HasError())
@@ -576,3 +678,133 @@ func TestCreatePushOptions_Auth_SshPasswordAuth_KnownHosts_Null(t *testing.T) {
 	assert.False(t, options.Force)
 	assert.NotNil(t, options.Auth)
 }
+
+func TestCreatePushOptions_ProviderDefaults_Auth(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.PushResourceModel{}
+	defaults := &provider.GitProviderModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.RefSpecs, _ = types.ListValueFrom(ctx, types.StringType, []string{"refs/heads/main"})
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	defaults.Auth = types.ObjectValueMust(
+		map[string]attr.Type{
+			"bearer": types.StringType,
+		},
+		map[string]attr.Value{
+			"bearer": types.StringValue("secret-token"),
+		},
+	)
+	options := provider.CreatePushOptions(ctx, model, defaults, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.IsType(t, &http.TokenAuth{}, options.Auth)
+}
+
+func TestCreatePushOptions_ProviderDefaults_Auth_Overridden(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.PushResourceModel{}
+	defaults := &provider.GitProviderModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.RefSpecs, _ = types.ListValueFrom(ctx, types.StringType, []string{"refs/heads/main"})
+	model.Auth = types.ObjectValueMust(
+		map[string]attr.Type{
+			"basic": types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"username": types.StringType,
+					"password": types.StringType,
+				},
+			},
+		},
+		map[string]attr.Value{
+			"basic": types.ObjectValueMust(
+				map[string]attr.Type{
+					"username": types.StringType,
+					"password": types.StringType,
+				},
+				map[string]attr.Value{
+					"username": types.StringValue("user"),
+					"password": types.StringValue("secret"),
+				},
+			),
+		},
+	)
+	defaults.Auth = types.ObjectValueMust(
+		map[string]attr.Type{
+			"bearer": types.StringType,
+		},
+		map[string]attr.Value{
+			"bearer": types.StringValue("secret-token"),
+		},
+	)
+	options := provider.CreatePushOptions(ctx, model, defaults, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.IsType(t, &http.BasicAuth{}, options.Auth)
+}
+
+func TestCreatePushOptions_ProviderDefaults_InsecureSkipTls(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.PushResourceModel{}
+	defaults := &provider.GitProviderModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.RefSpecs, _ = types.ListValueFrom(ctx, types.StringType, []string{"refs/heads/main"})
+	model.InsecureSkipTls = types.BoolNull()
+	defaults.InsecureSkipTls = types.BoolValue(true)
+	options := provider.CreatePushOptions(ctx, model, defaults, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.True(t, options.InsecureSkipTLS)
+}
+
+func TestCreatePushOptions_ProviderDefaults_InsecureSkipTls_Overridden(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.PushResourceModel{}
+	defaults := &provider.GitProviderModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.RefSpecs, _ = types.ListValueFrom(ctx, types.StringType, []string{"refs/heads/main"})
+	model.InsecureSkipTls = types.BoolValue(false)
+	defaults.InsecureSkipTls = types.BoolValue(true)
+	options := provider.CreatePushOptions(ctx, model, defaults, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.False(t, options.InsecureSkipTLS)
+}
+
+func TestCreatePushOptions_ProviderDefaults_CaBundleFilePath(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.PushResourceModel{}
+	defaults := &provider.GitProviderModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.RefSpecs, _ = types.ListValueFrom(ctx, types.StringType, []string{"refs/heads/main"})
+	model.CaBundleFilePath = types.StringNull()
+	defaults.CaBundleFilePath = types.StringValue("/some/non/existing/ca-bundle.pem")
+	options := provider.CreatePushOptions(ctx, model, defaults, diagnostics)
+
+	assert.Nil(t, options)
+	assert.True(t, diagnostics.HasError())
+}
+
+func TestCreatePushOptions_ProviderDefaults_CaBundleFilePath_Overridden(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.PushResourceModel{}
+	defaults := &provider.GitProviderModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.RefSpecs, _ = types.ListValueFrom(ctx, types.StringType, []string{"refs/heads/main"})
+	model.CaBundleFilePath = types.StringValue("")
+	defaults.CaBundleFilePath = types.StringValue("/some/non/existing/ca-bundle.pem")
+	options := provider.CreatePushOptions(ctx, model, defaults, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.Nil(t, options.CABundle)
+}
@@ -0,0 +1,90 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/metio/terraform-provider-git/internal/provider"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSubmoduleUpdateOptions_EmptyModel(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.SubmoduleResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	options := provider.CreateSubmoduleUpdateOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.False(t, options.Init)
+	assert.False(t, options.NoFetch)
+	assert.Equal(t, git.NoRecurseSubmodules, options.RecurseSubmodules)
+	assert.Equal(t, 0, options.Depth)
+	assert.Nil(t, options.Auth)
+}
+
+func TestCreateSubmoduleUpdateOptions_Init(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.SubmoduleResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.Init = types.BoolValue(true)
+	options := provider.CreateSubmoduleUpdateOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.True(t, options.Init)
+}
+
+func TestCreateSubmoduleUpdateOptions_NoFetch(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.SubmoduleResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.NoFetch = types.BoolValue(true)
+	options := provider.CreateSubmoduleUpdateOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.True(t, options.NoFetch)
+}
+
+func TestCreateSubmoduleUpdateOptions_Recursive(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.SubmoduleResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.Recursive = types.BoolValue(true)
+	options := provider.CreateSubmoduleUpdateOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.Equal(t, git.DefaultSubmoduleRecursionDepth, options.RecurseSubmodules)
+}
+
+func TestCreateSubmoduleUpdateOptions_Depth(t *testing.T) {
+	ctx := context.TODO()
+	model := &provider.SubmoduleResourceModel{}
+	diagnostics := &diag.Diagnostics{}
+
+	model.Auth = types.ObjectNull(map[string]attr.Type{})
+	model.Depth = types.Int64Value(1)
+	options := provider.CreateSubmoduleUpdateOptions(ctx, model, nil, diagnostics)
+
+	assert.NotNil(t, options)
+	assert.False(t, diagnostics.HasError())
+	assert.Equal(t, 1, options.Depth)
+}
@@ -7,11 +7,15 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type GitProvider struct{}
@@ -20,6 +24,16 @@ var (
 	_ provider.Provider = (*GitProvider)(nil)
 )
 
+// GitProviderModel contains the provider level defaults which are used by resources and data sources that leave the
+// corresponding attributes unset.
+type GitProviderModel struct {
+	Auth             types.Object `tfsdk:"auth"`
+	InsecureSkipTls  types.Bool   `tfsdk:"insecure_skip_tls"`
+	CaBundleFilePath types.String `tfsdk:"ca_bundle_file_path"`
+	Author           types.Object `tfsdk:"author"`
+	Committer        types.Object `tfsdk:"committer"`
+}
+
 func New() provider.Provider {
 	return &GitProvider{}
 }
@@ -32,11 +46,68 @@ func (p *GitProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *
 	resp.Schema = schema.Schema{
 		Description:         "Provider for local Git operations. Requires Terraform 1.0 or later.",
 		MarkdownDescription: "Provider for local [Git](https://git-scm.com/) operations. Requires Terraform 1.0 or later.",
+		Attributes: map[string]schema.Attribute{
+			"auth": authProviderAttribute(),
+			"insecure_skip_tls": schema.BoolAttribute{
+				Description:         "Skip SSL verification if protocol is HTTPS for all resources and data sources that talk to remote repositories. Defaults to 'false'.",
+				MarkdownDescription: "Skip SSL verification if protocol is HTTPS for all resources and data sources that talk to remote repositories. Defaults to `false`.",
+				Optional:            true,
+			},
+			"ca_bundle_file_path": schema.StringAttribute{
+				Description:         "File system path to an additional CA bundle to use together with the system cert pool. Resources and data sources that specify their own 'ca_bundle_file_path' attribute ignore this value.",
+				MarkdownDescription: "File system path to an additional CA bundle to use together with the system cert pool. Resources and data sources that specify their own `ca_bundle_file_path` attribute ignore this value.",
+				Optional:            true,
+			},
+			"author": schema.SingleNestedAttribute{
+				Description:         "The default author of new commits. If none is specified, the author will be read from the Git configuration.",
+				MarkdownDescription: "The default author of new commits. If none is specified, the author will be read from the Git configuration.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description:         "The name of the author.",
+						MarkdownDescription: "The name of the author.",
+						Optional:            true,
+					},
+					"email": schema.StringAttribute{
+						Description:         "The email address of the author.",
+						MarkdownDescription: "The email address of the author.",
+						Optional:            true,
+					},
+				},
+			},
+			"committer": schema.SingleNestedAttribute{
+				Description:         "The default committer of new commits. If none is specified, the author is used as committer.",
+				MarkdownDescription: "The default committer of new commits. If none is specified, the author is used as committer.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description:         "The name of the committer.",
+						MarkdownDescription: "The name of the committer.",
+						Optional:            true,
+					},
+					"email": schema.StringAttribute{
+						Description:         "The email address of the committer.",
+						MarkdownDescription: "The email address of the committer.",
+						Optional:            true,
+					},
+				},
+			},
+		},
 	}
 }
 
-func (p *GitProvider) Configure(_ context.Context, _ provider.ConfigureRequest, _ *provider.ConfigureResponse) {
-	// NO-OP: provider requires no configuration
+func (p *GitProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	tflog.Debug(ctx, "Configure provider git")
+
+	var config GitProviderModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.DataSourceData = &config
+	resp.ResourceData = &config
 }
 
 func (p *GitProvider) DataSources(_ context.Context) []func() datasource.DataSource {
@@ -45,25 +116,79 @@ func (p *GitProvider) DataSources(_ context.Context) []func() datasource.DataSou
 		NewBranchesDataSource,
 		NewCommitDataSource,
 		NewConfigDataSource,
+		NewDiffDataSource,
+		NewFileDataSource,
 		NewLogDataSource,
+		NewLsRemoteDataSource,
 		NewRemoteDataSource,
 		NewRemotesDataSource,
 		NewRepositoryDataSource,
 		NewStatusDataSource,
 		NewStatusesDataSource,
+		NewSubmodulesDataSource,
 		NewTagDataSource,
 		NewTagsDataSource,
+		NewTreeDataSource,
+		NewVerifyDataSource,
+		NewWorktreesDataSource,
 	}
 }
 
 func (p *GitProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewAddResource,
+		NewBranchResource,
+		NewCheckoutResource,
+		NewCherryPickResource,
 		NewCloneResource,
 		NewCommitResource,
+		NewConfigResource,
+		NewConfigsResource,
+		NewFetchResource,
+		NewFileResource,
 		NewInitResource,
+		NewMergeResource,
+		NewPullResource,
 		NewPushResource,
 		NewRemoteResource,
+		NewResetResource,
+		NewRevertResource,
+		NewSubmoduleResource,
 		NewTagResource,
+		NewWorktreeResource,
+	}
+}
+
+func providerDefaults(providerData any, diag *diag.Diagnostics) *GitProviderModel {
+	if providerData == nil {
+		// the provider has not been configured yet, e.g. during validation
+		return nil
+	}
+	defaults, ok := providerData.(*GitProviderModel)
+	if !ok {
+		diag.AddError(
+			"Unexpected provider data",
+			fmt.Sprintf("Expected *GitProviderModel, got: %T. Please report this issue to the provider developers.", providerData),
+		)
+		return nil
+	}
+	return defaults
+}
+
+// defaultInsecureSkipTls returns the value configured for a resource or data source and falls back to the provider
+// level default in case the attribute is unset. An explicit 'false' thus re-enables TLS verification.
+func defaultInsecureSkipTls(inputs types.Bool, defaults *GitProviderModel) bool {
+	if !inputs.IsNull() && !inputs.IsUnknown() || defaults == nil {
+		return inputs.ValueBool()
+	}
+	return defaults.InsecureSkipTls.ValueBool()
+}
+
+// defaultCaBundleFilePath returns the value configured for a resource or data source and falls back to the provider
+// level default in case the attribute is unset. An explicit empty string thus disables the provider level CA bundle.
+func defaultCaBundleFilePath(inputs types.String, defaults *GitProviderModel) string {
+	if !inputs.IsNull() && !inputs.IsUnknown() || defaults == nil {
+		return inputs.ValueString()
 	}
+	return defaults.CaBundleFilePath.ValueString()
 }
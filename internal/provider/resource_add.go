@@ -7,6 +7,9 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/bmatcuk/doublestar/v4"
@@ -26,8 +29,9 @@ import (
 type AddResource struct{}
 
 var (
-	_ resource.Resource               = (*AddResource)(nil)
-	_ resource.ResourceWithModifyPlan = (*AddResource)(nil)
+	_ resource.Resource                = (*AddResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*AddResource)(nil)
+	_ resource.ResourceWithImportState = (*AddResource)(nil)
 )
 
 type addResourceModel struct {
@@ -168,6 +172,62 @@ func (r *AddResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *r
 	// NO-OP: Terraform removes the state automatically for us
 }
 
+func (r *AddResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "ImportState resource git_add")
+
+	id := req.ID
+	idParts := strings.Split(id, "|")
+
+	if len(idParts) < 2 || slices.Contains(idParts, "") {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: 'path/to/your/git/repository|path/to/add' Got: %q", id),
+		)
+		return
+	}
+
+	directory := idParts[0]
+	paths := idParts[1:]
+
+	for _, pattern := range paths {
+		if !doublestar.ValidatePathPattern(pattern) {
+			resp.Diagnostics.AddError(
+				"Cannot match file path",
+				"The pattern ["+pattern+"] is not a valid glob pattern",
+			)
+			return
+		}
+	}
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	worktree, err := getWorktree(repository, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+	if worktree == nil {
+		resp.Diagnostics.AddError(
+			"Cannot add file to bare repository",
+			"The repository at ["+directory+"] is bare. Create a worktree first in order to add files to it.",
+		)
+		return
+	}
+
+	var state addResourceModel
+	state.Directory = types.StringValue(directory)
+	state.Id = types.Int64Value(time.Now().UnixNano())
+	state.Paths, _ = types.ListValueFrom(ctx, types.StringType, paths)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
 func (r *AddResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	tflog.Debug(ctx, "ModifyPlan resource git_add")
 
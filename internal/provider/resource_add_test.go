@@ -279,3 +279,65 @@ func TestResourceGitAdd_AddPaths_Update(t *testing.T) {
 		},
 	})
 }
+
+func TestResourceGitAdd_Import(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_add" "test" {
+						directory = "%s"
+						add_paths = ["some-file", "docs/**"]
+					}
+				`, directory),
+				ResourceName:       "git_add.test",
+				ImportState:        true,
+				ImportStateId:      fmt.Sprintf("%s|%s|%s", directory, "some-file", "docs/**"),
+				ImportStatePersist: true,
+				ImportStateCheck: testutils.ComposeImportStateCheck(
+					testutils.CheckResourceAttrInstanceState("directory", directory),
+					testutils.CheckResourceAttrInstanceState("add_paths.#", "2"),
+					testutils.CheckResourceAttrInstanceState("add_paths.0", "some-file"),
+					testutils.CheckResourceAttrInstanceState("add_paths.1", "docs/**"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_add" "test" {
+						directory = "%s"
+						add_paths = ["some-file", "docs/**"]
+					}
+				`, directory),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceGitAdd_Import_BareRepository(t *testing.T) {
+	t.Parallel()
+	directory := testutils.CreateBareRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_add" "test" {
+						directory = "%s"
+						add_paths = ["some-file"]
+					}
+				`, directory),
+				ResourceName:       "git_add.test",
+				ImportState:        true,
+				ImportStateId:      fmt.Sprintf("%s|%s", directory, "some-file"),
+				ImportStatePersist: false,
+				ExpectError:        regexp.MustCompile(`Cannot add file to bare repository`),
+			},
+		},
+	})
+}
@@ -0,0 +1,478 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/metio/terraform-provider-git/internal/modifiers"
+)
+
+type BranchResource struct{}
+
+var (
+	_ resource.Resource                = (*BranchResource)(nil)
+	_ resource.ResourceWithImportState = (*BranchResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*BranchResource)(nil)
+)
+
+type branchResourceModel struct {
+	Directory types.String `tfsdk:"directory"`
+	Id        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Revision  types.String `tfsdk:"revision"`
+	Force     types.Bool   `tfsdk:"force"`
+	Remote    types.String `tfsdk:"remote"`
+	Merge     types.String `tfsdk:"merge"`
+	Rebase    types.String `tfsdk:"rebase"`
+	SHA1      types.String `tfsdk:"sha1"`
+}
+
+func NewBranchResource() resource.Resource {
+	return &BranchResource{}
+}
+
+func (r *BranchResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branch"
+}
+
+func (r *BranchResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manage Git branches similar to 'git branch'.",
+		MarkdownDescription: "Manage Git branches similar to `git branch`.",
+		Attributes: map[string]schema.Attribute{
+			"directory": schema.StringAttribute{
+				Description:         "The path to the local Git repository.",
+				MarkdownDescription: "The path to the local Git repository.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description:         "The import ID to import this resource which has the form 'directory|name'",
+				MarkdownDescription: "The import ID to import this resource which has the form `'directory|name'`",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				Description:         "The name of the Git branch to manage.",
+				MarkdownDescription: "The name of the Git branch to manage.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"revision": schema.StringAttribute{
+				Description:         "The revision of the commit the branch should point to. Can be any value that 'go-git' supports. If none is specified, 'HEAD' will be used. The branch is moved whenever the revision resolves to a different commit.",
+				MarkdownDescription: "The [revision](https://www.git-scm.com/docs/gitrevisions) of the commit the branch should point to. Can be any value that `go-git` [supports](https://pkg.go.dev/github.com/go-git/go-git/v5#Repository.ResolveRevision). If none is specified, `HEAD` will be used. The branch is moved whenever the revision resolves to a different commit.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					modifiers.DefaultString("HEAD"),
+				},
+			},
+			"force": schema.BoolAttribute{
+				Description:         "Reset the branch to the given revision even if the branch already exists or the move is not a fast-forward. Defaults to 'false'.",
+				MarkdownDescription: "Reset the branch to the given revision even if the branch already exists or the move is not a fast-forward. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+				},
+			},
+			"remote": schema.StringAttribute{
+				Description:         "The remote to track with this branch, written to 'branch.<name>.remote'.",
+				MarkdownDescription: "The remote to track with this branch, written to `branch.<name>.remote`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"merge": schema.StringAttribute{
+				Description:         "The remote branch to track with this branch, e.g. 'main' or 'refs/heads/main', written to 'branch.<name>.merge'.",
+				MarkdownDescription: "The remote branch to track with this branch, e.g. `main` or `refs/heads/main`, written to `branch.<name>.merge`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"rebase": schema.StringAttribute{
+				Description:         "The rebase configuration for this branch, written to 'branch.<name>.rebase'. Possible values are 'true', 'interactive', and 'false'.",
+				MarkdownDescription: "The rebase configuration for this branch, written to `branch.<name>.rebase`. Possible values are `true`, `interactive`, and `false`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("true", "interactive", "false"),
+				},
+			},
+			"sha1": schema.StringAttribute{
+				Description:         "The SHA1 hash of the commit the branch points to.",
+				MarkdownDescription: "The SHA1 hash of the commit the branch points to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BranchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create resource git_branch")
+
+	var inputs branchResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := inputs.Directory.ValueString()
+	branchName := inputs.Name.ValueString()
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	hash := resolveRevision(ctx, repository, inputs.Revision.ValueString(), &resp.Diagnostics)
+	if hash == nil {
+		return
+	}
+
+	existing, err := getBranchReference(ctx, repository, branchName, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+	if existing != nil && !inputs.Force.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Cannot create branch",
+			"Could not create branch ["+branchName+"] in git repository ["+directory+"] because it already exists. Set 'force' to reset the existing branch or import it instead.",
+		)
+		return
+	}
+
+	setBranchReference(ctx, repository, branchName, *hash, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setBranchConfig(ctx, repository, branchName, inputs.Remote, inputs.Merge, inputs.Rebase, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created branch", map[string]interface{}{
+		"directory": directory,
+		"branch":    branchName,
+	})
+
+	var state branchResourceModel
+	state.Directory = inputs.Directory
+	state.Id = types.StringValue(fmt.Sprintf("%s|%s", directory, branchName))
+	state.Name = inputs.Name
+	state.Revision = inputs.Revision
+	state.Force = inputs.Force
+	state.Remote = inputs.Remote
+	state.Merge = inputs.Merge
+	state.Rebase = inputs.Rebase
+	state.SHA1 = types.StringValue(hash.String())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *BranchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Read resource git_branch")
+
+	var state branchResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := state.Directory.ValueString()
+	branchName := state.Name.ValueString()
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	reference, err := getBranchReference(ctx, repository, branchName, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+	if reference == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var newState branchResourceModel
+	newState.Directory = state.Directory
+	newState.Id = types.StringValue(fmt.Sprintf("%s|%s", directory, branchName))
+	newState.Name = state.Name
+	newState.Revision = state.Revision
+	newState.Force = state.Force
+	newState.SHA1 = types.StringValue(reference.Hash().String())
+	readBranchTracking(ctx, repository, &state, &newState, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *BranchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Update resource git_branch")
+
+	var inputs branchResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := inputs.Directory.ValueString()
+	branchName := inputs.Name.ValueString()
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	hash := resolveRevision(ctx, repository, inputs.Revision.ValueString(), &resp.Diagnostics)
+	if hash == nil {
+		return
+	}
+
+	setBranchReference(ctx, repository, branchName, *hash, inputs.Force.ValueBool(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setBranchConfig(ctx, repository, branchName, inputs.Remote, inputs.Merge, inputs.Rebase, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state branchResourceModel
+	state.Directory = inputs.Directory
+	state.Id = types.StringValue(fmt.Sprintf("%s|%s", directory, branchName))
+	state.Name = inputs.Name
+	state.Revision = inputs.Revision
+	state.Force = inputs.Force
+	state.Remote = inputs.Remote
+	state.Merge = inputs.Merge
+	state.Rebase = inputs.Rebase
+	state.SHA1 = types.StringValue(hash.String())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *BranchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Delete resource git_branch")
+
+	var state branchResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := state.Directory.ValueString()
+	branchName := state.Name.ValueString()
+	referenceName := plumbing.NewBranchReferenceName(branchName)
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	head, err := repository.Reference(plumbing.HEAD, false)
+	if err == nil && head.Type() == plumbing.SymbolicReference && head.Target() == referenceName {
+		resp.Diagnostics.AddError(
+			"Cannot delete branch",
+			"Could not delete branch ["+branchName+"] in git repository ["+directory+"] because it is currently checked out.",
+		)
+		return
+	}
+
+	err = repository.Storer.RemoveReference(referenceName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Cannot delete branch",
+			"Could not delete branch ["+branchName+"] in git repository ["+directory+"] because of: "+err.Error(),
+		)
+		return
+	}
+
+	setBranchConfig(ctx, repository, branchName, types.StringNull(), types.StringNull(), types.StringNull(), &resp.Diagnostics)
+}
+
+func (r *BranchResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "ImportState resource git_branch")
+
+	id := req.ID
+	idParts := strings.Split(id, "|")
+
+	if len(idParts) < 2 || len(idParts) > 3 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: 'path/to/your/git/repository|name-of-your-branch|revision' Got: %q", id),
+		)
+		return
+	}
+
+	directory := idParts[0]
+	branchName := idParts[1]
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	reference, err := getBranchReference(ctx, repository, branchName, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+	if reference == nil {
+		resp.Diagnostics.AddError(
+			"Cannot read branch",
+			"The branch ["+branchName+"] does not exist in ["+directory+"]",
+		)
+		return
+	}
+
+	var revision string
+	if len(idParts) == 2 {
+		revision = "HEAD"
+	} else {
+		revision = idParts[2]
+	}
+
+	var state branchResourceModel
+	state.Directory = types.StringValue(directory)
+	state.Id = types.StringValue(fmt.Sprintf("%s|%s", directory, branchName))
+	state.Name = types.StringValue(branchName)
+	state.Revision = types.StringValue(revision)
+	state.Force = types.BoolValue(false)
+	state.SHA1 = types.StringValue(reference.Hash().String())
+	readBranchTracking(ctx, repository, &branchResourceModel{}, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *BranchResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	tflog.Debug(ctx, "ModifyPlan resource git_branch")
+
+	if req.State.Raw.IsNull() {
+		// if we're creating the resource, no need to modify it
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		// if we're deleting the resource, no need to modify it
+		return
+	}
+
+	var inputs branchResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sha1 := path.Root("sha1")
+
+	if inputs.Revision.IsUnknown() {
+		resp.Plan.SetAttribute(ctx, sha1, types.StringUnknown())
+		return
+	}
+
+	repository := openRepository(ctx, inputs.Directory.ValueString(), &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	hash, err := repository.ResolveRevision(plumbing.Revision(inputs.Revision.ValueString()))
+	if err != nil {
+		// the revision might be created during apply, thus we cannot know the resulting hash yet
+		resp.Plan.SetAttribute(ctx, sha1, types.StringUnknown())
+		return
+	}
+
+	if inputs.SHA1.ValueString() != hash.String() {
+		resp.Plan.SetAttribute(ctx, sha1, hash.String())
+	}
+}
+
+// readBranchTracking copies the tracking configuration of a branch into the given new state. Values that are
+// equivalent to the ones in the previous state are kept as-is in order to avoid spurious diffs, e.g. 'main' vs.
+// 'refs/heads/main' in the merge configuration.
+func readBranchTracking(ctx context.Context, repository *git.Repository, previous *branchResourceModel, newState *branchResourceModel, diag *diag.Diagnostics) {
+	newState.Remote = types.StringNull()
+	newState.Merge = types.StringNull()
+	newState.Rebase = types.StringNull()
+
+	branch := readBranchConfig(ctx, repository, newState.Name.ValueString(), diag)
+	if branch == nil {
+		return
+	}
+
+	if branch.Remote != "" {
+		newState.Remote = types.StringValue(branch.Remote)
+	}
+	if branch.Merge != "" {
+		if !previous.Merge.IsNull() && expandBranchReferenceName(previous.Merge.ValueString()) == branch.Merge {
+			newState.Merge = previous.Merge
+		} else {
+			newState.Merge = types.StringValue(branch.Merge.String())
+		}
+	}
+	if branch.Rebase != "" {
+		newState.Rebase = types.StringValue(branch.Rebase)
+	}
+}
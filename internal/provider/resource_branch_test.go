@@ -0,0 +1,316 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/metio/terraform-provider-git/internal/testutils"
+)
+
+func TestResourceGitBranch(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	head := testutils.GetRepositoryHead(t, repository)
+	name := "some-branch"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_branch" "test" {
+						directory = "%s"
+						name      = "%s"
+					}
+				`, directory, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_branch.test", "directory", directory),
+					resource.TestCheckResourceAttr("git_branch.test", "id", fmt.Sprintf("%s|%s", directory, name)),
+					resource.TestCheckResourceAttr("git_branch.test", "name", name),
+					resource.TestCheckResourceAttr("git_branch.test", "revision", "HEAD"),
+					resource.TestCheckResourceAttr("git_branch.test", "force", "false"),
+					resource.TestCheckNoResourceAttr("git_branch.test", "remote"),
+					resource.TestCheckNoResourceAttr("git_branch.test", "merge"),
+					resource.TestCheckNoResourceAttr("git_branch.test", "rebase"),
+					resource.TestCheckResourceAttr("git_branch.test", "sha1", head.Hash().String()),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitBranch_Tracking(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	name := "some-branch"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_branch" "test" {
+						directory = "%s"
+						name      = "%s"
+						remote    = "origin"
+						merge     = "main"
+						rebase    = "true"
+					}
+					data "git_branch" "test" {
+						directory  = git_branch.test.directory
+						name       = git_branch.test.name
+					}
+				`, directory, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_branch.test", "remote", "origin"),
+					resource.TestCheckResourceAttr("git_branch.test", "merge", "main"),
+					resource.TestCheckResourceAttr("git_branch.test", "rebase", "true"),
+					resource.TestCheckResourceAttr("data.git_branch.test", "remote", "origin"),
+					resource.TestCheckResourceAttr("data.git_branch.test", "rebase", "true"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_branch" "test" {
+						directory = "%s"
+						name      = "%s"
+						remote    = "upstream"
+					}
+					data "git_branch" "test" {
+						directory  = git_branch.test.directory
+						name       = git_branch.test.name
+					}
+				`, directory, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_branch.test", "remote", "upstream"),
+					resource.TestCheckNoResourceAttr("git_branch.test", "merge"),
+					resource.TestCheckNoResourceAttr("git_branch.test", "rebase"),
+					resource.TestCheckResourceAttr("data.git_branch.test", "remote", "upstream"),
+					resource.TestCheckResourceAttr("data.git_branch.test", "rebase", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitBranch_Revision_Update(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	first := testutils.GetRepositoryHead(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	second := testutils.GetRepositoryHead(t, repository)
+	name := "some-branch"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_branch" "test" {
+						directory = "%s"
+						name      = "%s"
+						revision  = "%s"
+					}
+				`, directory, name, first.Hash().String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_branch.test", "sha1", first.Hash().String()),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_branch" "test" {
+						directory = "%s"
+						name      = "%s"
+						revision  = "%s"
+					}
+				`, directory, name, second.Hash().String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_branch.test", "sha1", second.Hash().String()),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_branch" "test" {
+						directory = "%s"
+						name      = "%s"
+						revision  = "%s"
+					}
+				`, directory, name, first.Hash().String()),
+				ExpectError: regexp.MustCompile(`Cannot move branch`),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_branch" "test" {
+						directory = "%s"
+						name      = "%s"
+						revision  = "%s"
+						force     = true
+					}
+				`, directory, name, first.Hash().String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_branch.test", "sha1", first.Hash().String()),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitBranch_Exists(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_branch" "test" {
+						directory = "%s"
+						name      = "master"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot create branch`),
+			},
+		},
+	})
+}
+
+func TestResourceGitBranch_Rebase_Invalid(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_branch" "test" {
+						directory = "%s"
+						name      = "some-branch"
+						rebase    = "sometimes"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
+func TestResourceGitBranch_Directory_Invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "git_branch" "test" {
+						directory = "/does/not/exist"
+						name      = "some-branch"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Cannot open repository`),
+			},
+		},
+	})
+}
+
+func TestResourceGitBranch_Import(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	head := testutils.GetRepositoryHead(t, repository)
+	name := "some-branch"
+	testutils.CreateBranch(t, repository, &config.Branch{
+		Name:   name,
+		Remote: "origin",
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_branch" "test" {
+						directory = "%s"
+						name      = "%s"
+						remote    = "origin"
+					}
+				`, directory, name),
+				ResourceName:       "git_branch.test",
+				ImportState:        true,
+				ImportStateId:      fmt.Sprintf("%s|%s", directory, name),
+				ImportStatePersist: true,
+				ImportStateCheck: testutils.ComposeImportStateCheck(
+					testutils.CheckResourceAttrInstanceState("directory", directory),
+					testutils.CheckResourceAttrInstanceState("id", fmt.Sprintf("%s|%s", directory, name)),
+					testutils.CheckResourceAttrInstanceState("name", name),
+					testutils.CheckResourceAttrInstanceState("revision", "HEAD"),
+					testutils.CheckResourceAttrInstanceState("remote", "origin"),
+					testutils.CheckResourceAttrInstanceState("sha1", head.Hash().String()),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_branch" "test" {
+						directory = "%s"
+						name      = "%s"
+						remote    = "origin"
+					}
+				`, directory, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_branch.test", "remote", "origin"),
+					resource.TestCheckResourceAttr("git_branch.test", "sha1", head.Hash().String()),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitBranch_Import_NonExistingBranch(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_branch" "test" {
+						directory = "%s"
+						name      = "does-not-exist"
+					}
+				`, directory),
+				ResourceName:  "git_branch.test",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s|%s", directory, "does-not-exist"),
+				ExpectError:   regexp.MustCompile(`Cannot read branch`),
+			},
+		},
+	})
+}
@@ -0,0 +1,299 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/metio/terraform-provider-git/internal/modifiers"
+)
+
+type CheckoutResource struct{}
+
+var (
+	_ resource.Resource               = (*CheckoutResource)(nil)
+	_ resource.ResourceWithModifyPlan = (*CheckoutResource)(nil)
+)
+
+type checkoutResourceModel struct {
+	Directory types.String `tfsdk:"directory"`
+	Id        types.String `tfsdk:"id"`
+	Branch    types.String `tfsdk:"branch"`
+	Hash      types.String `tfsdk:"hash"`
+	Create    types.Bool   `tfsdk:"create"`
+	Force     types.Bool   `tfsdk:"force"`
+	Keep      types.Bool   `tfsdk:"keep"`
+	SHA1      types.String `tfsdk:"sha1"`
+}
+
+func NewCheckoutResource() resource.Resource {
+	return &CheckoutResource{}
+}
+
+func (r *CheckoutResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_checkout"
+}
+
+func (r *CheckoutResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Switch branches or restore worktree files similar to 'git checkout'.",
+		MarkdownDescription: "Switch branches or restore worktree files similar to `git checkout`.",
+		Attributes: map[string]schema.Attribute{
+			"directory": schema.StringAttribute{
+				Description:         "The path to the local Git repository.",
+				MarkdownDescription: "The path to the local Git repository.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description:         "The same value as the 'directory' attribute.",
+				MarkdownDescription: "The same value as the `directory` attribute.",
+				Computed:            true,
+			},
+			"branch": schema.StringAttribute{
+				Description:         "The branch to check out, e.g. 'main' or 'refs/heads/main'. Other fully qualified references like 'refs/tags/v1.0.0' are checked out in detached mode. Conflicts with 'hash' unless 'create' is set. If neither 'branch' nor 'hash' is specified, 'master' will be checked out.",
+				MarkdownDescription: "The branch to check out, e.g. `main` or `refs/heads/main`. Other fully qualified references like `refs/tags/v1.0.0` are checked out in detached mode. Conflicts with `hash` unless `create` is set. If neither `branch` nor `hash` is specified, `master` will be checked out.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hash": schema.StringAttribute{
+				Description:         "The commit or tag to check out in detached mode. Can be any revision that 'go-git' supports. The revision is resolved once during the checkout. In case 'create' is set, the new branch will start at this commit.",
+				MarkdownDescription: "The commit or tag to check out in detached mode. Can be any [revision](https://www.git-scm.com/docs/gitrevisions) that `go-git` [supports](https://pkg.go.dev/github.com/go-git/go-git/v5#Repository.ResolveRevision). The revision is resolved once during the checkout. In case `create` is set, the new branch will start at this commit.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"create": schema.BoolAttribute{
+				Description:         "Create a new branch named 'branch' and start it at 'hash' or the current 'HEAD'. In case the branch already exists, e.g. because the resource is replaced, it is reset to the start point similar to 'git checkout -B'. Defaults to 'false'.",
+				MarkdownDescription: "Create a new branch named `branch` and start it at `hash` or the current `HEAD`. In case the branch already exists, e.g. because the resource is replaced, it is reset to the start point similar to `git checkout -B`. Defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"force": schema.BoolAttribute{
+				Description:         "Proceed even if the index or the worktree differs from 'HEAD'. Local changes will be thrown away. Conflicts with 'keep'. Defaults to 'false'.",
+				MarkdownDescription: "Proceed even if the index or the worktree differs from `HEAD`. Local changes will be thrown away. Conflicts with `keep`. Defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"keep": schema.BoolAttribute{
+				Description:         "Keep local changes in the index and the worktree so that they can be committed to the target branch. Conflicts with 'force'. Defaults to 'false'.",
+				MarkdownDescription: "Keep local changes in the index and the worktree so that they can be committed to the target branch. Conflicts with `force`. Defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"sha1": schema.StringAttribute{
+				Description:         "The SHA1 hash of 'HEAD' after the checkout.",
+				MarkdownDescription: "The SHA1 hash of `HEAD` after the checkout.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CheckoutResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create resource git_checkout")
+
+	var inputs checkoutResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := inputs.Directory.ValueString()
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	worktree, err := getWorktree(repository, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+	if worktree == nil {
+		resp.Diagnostics.AddError(
+			"Cannot checkout in bare repository",
+			"The repository at ["+directory+"] is bare. Create a worktree first in order to check out files.",
+		)
+		return
+	}
+
+	options := createCheckoutOptions(ctx, repository, inputs, &resp.Diagnostics)
+	if options == nil {
+		return
+	}
+
+	_, err = repository.Reference(options.Branch, false)
+	if options.Create && err == nil {
+		// the branch was created by a previous checkout which is now being replaced
+		if !resetExistingBranch(ctx, repository, worktree, options, &resp.Diagnostics) {
+			return
+		}
+	} else {
+		err = worktree.Checkout(options)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Cannot checkout",
+				"Could not checkout in repository ["+directory+"] because of: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	head, err := repository.Head()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Cannot read HEAD",
+			"Could not read HEAD of repository ["+directory+"] because of: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "checked out", map[string]interface{}{
+		"directory": directory,
+		"head":      head.Hash().String(),
+	})
+
+	var state checkoutResourceModel
+	state.Directory = inputs.Directory
+	state.Id = inputs.Directory
+	state.Branch = inputs.Branch
+	state.Hash = inputs.Hash
+	state.Create = inputs.Create
+	state.Force = inputs.Force
+	state.Keep = inputs.Keep
+	state.SHA1 = types.StringValue(head.Hash().String())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CheckoutResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Read resource git_checkout")
+
+	var state checkoutResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := state.Directory.ValueString()
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// detached checkouts keep the commit resolved during the checkout in order to detect changes to HEAD
+	if branch := checkoutBranch(state); branch != "" {
+		tip, err := repository.Reference(branch, true)
+		if err == nil {
+			state.SHA1 = types.StringValue(tip.Hash().String())
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CheckoutResource) Update(ctx context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Update resource git_checkout")
+	// NO-OP: All attributes require replacement, thus delete/create will be called
+}
+
+func (r *CheckoutResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Delete resource git_checkout")
+	// NO-OP: Terraform removes the state automatically for us
+}
+
+func (r *CheckoutResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	tflog.Debug(ctx, "ModifyPlan resource git_checkout")
+
+	if req.State.Raw.IsNull() {
+		// if we're creating the resource, no need to modify it
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		// if we're deleting the resource, no need to modify it
+		return
+	}
+
+	var inputs checkoutResourceModel
+	var state checkoutResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repository := openRepository(ctx, inputs.Directory.ValueString(), &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	matches := headMatchesCheckout(ctx, repository, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !matches {
+		// someone changed HEAD by hand, thus we have to check out again
+		sha1 := path.Root("sha1")
+		resp.Plan.SetAttribute(ctx, sha1, types.StringUnknown())
+		resp.RequiresReplace = append(resp.RequiresReplace, sha1)
+	}
+}
@@ -0,0 +1,266 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/metio/terraform-provider-git/internal/testutils"
+)
+
+func TestResourceGitCheckout_Branch(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	head := testutils.GetRepositoryHead(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_checkout" "test" {
+						directory = "%s"
+						branch    = "feature"
+						create    = true
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_checkout.test", "directory", directory),
+					resource.TestCheckResourceAttr("git_checkout.test", "id", directory),
+					resource.TestCheckResourceAttr("git_checkout.test", "branch", "feature"),
+					resource.TestCheckNoResourceAttr("git_checkout.test", "hash"),
+					resource.TestCheckResourceAttr("git_checkout.test", "create", "true"),
+					resource.TestCheckResourceAttr("git_checkout.test", "force", "false"),
+					resource.TestCheckResourceAttr("git_checkout.test", "keep", "false"),
+					resource.TestCheckResourceAttr("git_checkout.test", "sha1", head.Hash().String()),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitCheckout_Tag(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	tagged := testutils.GetRepositoryHead(t, repository)
+	testutils.CreateTag(t, repository, "v1.0.0")
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_checkout" "test" {
+						directory = "%s"
+						hash      = "v1.0.0"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_checkout.test", "hash", "v1.0.0"),
+					resource.TestCheckResourceAttr("git_checkout.test", "sha1", tagged.Hash().String()),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitCheckout_Drift(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	first := testutils.GetRepositoryHead(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	second := testutils.GetRepositoryHead(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_checkout" "test" {
+						directory = "%s"
+						hash      = "%s"
+					}
+				`, directory, first.Hash().String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_checkout.test", "sha1", first.Hash().String()),
+				),
+			},
+			{
+				PreConfig: func() {
+					testutils.TestGitCheckout(t, worktree, second.Hash())
+				},
+				Config: fmt.Sprintf(`
+					resource "git_checkout" "test" {
+						directory = "%s"
+						hash      = "%s"
+					}
+				`, directory, first.Hash().String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_checkout.test", "sha1", first.Hash().String()),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitCheckout_RelativeHash(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	first := testutils.GetRepositoryHead(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_checkout" "test" {
+						directory = "%s"
+						hash      = "HEAD~1"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_checkout.test", "sha1", first.Hash().String()),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_checkout" "test" {
+						directory = "%s"
+						hash      = "HEAD~1"
+					}
+				`, directory),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceGitCheckout_Create_Replace(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	head := testutils.GetRepositoryHead(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_checkout" "test" {
+						directory = "%s"
+						branch    = "feature"
+						create    = true
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_checkout.test", "sha1", head.Hash().String()),
+				),
+			},
+			{
+				PreConfig: func() {
+					testutils.GitCheckoutBranch(t, worktree, "master", false)
+				},
+				Config: fmt.Sprintf(`
+					resource "git_checkout" "test" {
+						directory = "%s"
+						branch    = "feature"
+						create    = true
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_checkout.test", "sha1", head.Hash().String()),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitCheckout_ForceAndKeep(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_checkout" "test" {
+						directory = "%s"
+						branch    = "master"
+						force     = true
+						keep      = true
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Invalid checkout options`),
+			},
+		},
+	})
+}
+
+func TestResourceGitCheckout_Branch_Unknown(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_checkout" "test" {
+						directory = "%s"
+						branch    = "does-not-exist"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot checkout`),
+			},
+		},
+	})
+}
+
+func TestResourceGitCheckout_BareRepository(t *testing.T) {
+	t.Parallel()
+	directory := testutils.CreateBareRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_checkout" "test" {
+						directory = "%s"
+						branch    = "master"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot checkout in bare repository`),
+			},
+		},
+	})
+}
@@ -0,0 +1,341 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/metio/terraform-provider-git/internal/testutils"
+)
+
+func TestResourceGitCherryPick(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	cfg := testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.GitCheckoutBranch(t, worktree, "feature", true)
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	testutils.GitCheckoutBranch(t, worktree, "master", false)
+	head := testutils.GetRepositoryHead(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_cherry_pick" "test" {
+						directory = "%s"
+						revisions = ["feature"]
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_cherry_pick.test", "directory", directory),
+					resource.TestCheckResourceAttrWith("git_cherry_pick.test", "id", testutils.CheckMinLength(1)),
+					resource.TestCheckResourceAttr("git_cherry_pick.test", "revisions.#", "1"),
+					resource.TestCheckResourceAttr("git_cherry_pick.test", "revisions.0", "feature"),
+					resource.TestCheckNoResourceAttr("git_cherry_pick.test", "author.name"),
+					resource.TestCheckNoResourceAttr("git_cherry_pick.test", "committer.name"),
+					resource.TestCheckResourceAttr("git_cherry_pick.test", "sha1.#", "1"),
+					resource.TestCheckResourceAttrWith("git_cherry_pick.test", "sha1.0", func(value string) error {
+						commit, err := repository.CommitObject(plumbing.NewHash(value))
+						if err != nil {
+							return err
+						}
+						if commit.Message != "example go-git commit" {
+							return fmt.Errorf("unexpected commit message %q", commit.Message)
+						}
+						if commit.Author.Name != "Some Person" {
+							return fmt.Errorf("expected author of the picked commit to be preserved, got %q", commit.Author.Name)
+						}
+						if commit.Committer.Name != cfg.Committer.Name {
+							return fmt.Errorf("expected committer %q, got %q", cfg.Committer.Name, commit.Committer.Name)
+						}
+						if commit.NumParents() != 1 || commit.ParentHashes[0] != head.Hash() {
+							return fmt.Errorf("expected commit to be created on top of %s", head.Hash())
+						}
+						return nil
+					}),
+					testutils.CheckFileContent(worktree, "other-file", "hello world!"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitCherryPick_Multiple(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.GitCheckoutBranch(t, worktree, "feature", true)
+	testutils.AddAndCommitNewFile(t, worktree, "first-file")
+	testutils.AddAndCommitNewFile(t, worktree, "second-file")
+	testutils.AddAndCommitNewFile(t, worktree, "third-file")
+	testutils.GitCheckoutBranch(t, worktree, "master", false)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_cherry_pick" "test" {
+						directory = "%s"
+						revisions = ["feature~2", "feature"]
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_cherry_pick.test", "sha1.#", "2"),
+					resource.TestCheckResourceAttrWith("git_cherry_pick.test", "sha1.1", func(value string) error {
+						head := testutils.GetRepositoryHead(t, repository)
+						if head.Hash().String() != value {
+							return fmt.Errorf("expected HEAD to point to the last created commit %s, got %s", value, head.Hash())
+						}
+						return nil
+					}),
+					testutils.CheckFileContent(worktree, "first-file", "hello world!"),
+					testutils.CheckFileContent(worktree, "third-file", "hello world!"),
+					func(_ *terraform.State) error {
+						if _, err := worktree.Filesystem.Stat("second-file"); err == nil {
+							return fmt.Errorf("expected 'second-file' to not be picked")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitCherryPick_Author(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.GitCheckoutBranch(t, worktree, "feature", true)
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	testutils.GitCheckoutBranch(t, worktree, "master", false)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_cherry_pick" "test" {
+						directory = "%s"
+						revisions = ["feature"]
+						author = {
+							name  = "terraform"
+							email = "automation@example.com"
+						}
+						committer = {
+							name  = "committer"
+							email = "committer@example.com"
+						}
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_cherry_pick.test", "author.name", "terraform"),
+					resource.TestCheckResourceAttr("git_cherry_pick.test", "author.email", "automation@example.com"),
+					resource.TestCheckResourceAttr("git_cherry_pick.test", "committer.name", "committer"),
+					resource.TestCheckResourceAttr("git_cherry_pick.test", "committer.email", "committer@example.com"),
+					resource.TestCheckResourceAttrWith("git_cherry_pick.test", "sha1.0", func(value string) error {
+						commit, err := repository.CommitObject(plumbing.NewHash(value))
+						if err != nil {
+							return err
+						}
+						if commit.Author.Name != "terraform" || commit.Author.Email != "automation@example.com" {
+							return fmt.Errorf("unexpected author %q", commit.Author.String())
+						}
+						if commit.Committer.Name != "committer" || commit.Committer.Email != "committer@example.com" {
+							return fmt.Errorf("unexpected committer %q", commit.Committer.String())
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitCherryPick_Conflict(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	name := "some-file"
+	testutils.AddAndCommitNewFile(t, worktree, name)
+	testutils.GitCheckoutBranch(t, worktree, "feature", true)
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	testutils.WriteFileContent(t, testutils.FileInWorktree(worktree, name), "feature")
+	testutils.GitAdd(t, worktree, name)
+	testutils.GitCommit(t, worktree)
+	testutils.GitCheckoutBranch(t, worktree, "master", false)
+	testutils.WriteFileContent(t, testutils.FileInWorktree(worktree, name), "master")
+	testutils.GitAdd(t, worktree, name)
+	testutils.GitCommit(t, worktree)
+	head := testutils.GetRepositoryHead(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_cherry_pick" "test" {
+						directory = "%s"
+						revisions = ["feature~1", "feature"]
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot cherry-pick conflicting changes`),
+			},
+		},
+	})
+
+	if current := testutils.GetRepositoryHead(t, repository); current.Hash() != head.Hash() {
+		t.Errorf("expected HEAD to stay at %s, got %s", head.Hash(), current.Hash())
+	}
+	if _, err := worktree.Filesystem.Stat("other-file"); err == nil {
+		t.Errorf("expected changes of the first commit to not be applied")
+	}
+}
+
+func TestResourceGitCherryPick_AlreadyApplied(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.GitCheckoutBranch(t, worktree, "feature", true)
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	testutils.GitCheckoutBranch(t, worktree, "master", false)
+	head := testutils.GetRepositoryHead(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_cherry_pick" "test" {
+						directory = "%s"
+						revisions = ["feature", "feature"]
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot cherry-pick empty commit`),
+			},
+		},
+	})
+
+	if current := testutils.GetRepositoryHead(t, repository); current.Hash() != head.Hash() {
+		t.Errorf("expected HEAD to stay at %s, got %s", head.Hash(), current.Hash())
+	}
+}
+
+func TestResourceGitCherryPick_MergeCommit(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	first := testutils.GetRepositoryHead(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	second := testutils.GetRepositoryHead(t, repository)
+	testutils.GitCommitWith(t, worktree, &git.CommitOptions{
+		Author:            testutils.Signature(),
+		AllowEmptyCommits: true,
+		Parents:           []plumbing.Hash{second.Hash(), first.Hash()},
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_cherry_pick" "test" {
+						directory = "%s"
+						revisions = ["HEAD"]
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot pick merge commit`),
+			},
+		},
+	})
+}
+
+func TestResourceGitCherryPick_LocalChanges(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	name := "some-file"
+	testutils.AddAndCommitNewFile(t, worktree, name)
+	testutils.GitCheckoutBranch(t, worktree, "feature", true)
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	testutils.GitCheckoutBranch(t, worktree, "master", false)
+	testutils.WriteFileContent(t, testutils.FileInWorktree(worktree, name), "changed")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_cherry_pick" "test" {
+						directory = "%s"
+						revisions = ["feature"]
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot cherry-pick with local changes`),
+			},
+		},
+	})
+}
+
+func TestResourceGitCherryPick_BareRepository(t *testing.T) {
+	t.Parallel()
+	directory := testutils.CreateBareRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_cherry_pick" "test" {
+						directory = "%s"
+						revisions = ["feature"]
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot cherry-pick in bare repository`),
+			},
+		},
+	})
+}
+
+func TestResourceGitCherryPick_Revisions_Empty(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_cherry_pick" "test" {
+						directory = "%s"
+						revisions = []
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+			},
+		},
+	})
+}
@@ -7,24 +7,22 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
-	"runtime"
 	"strings"
 
 	"github.com/go-git/go-git/v5"
-	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/storage/filesystem"
-	"github.com/go-git/go-git/v5/storage/memory"
-	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -32,24 +30,34 @@ import (
 	"github.com/metio/terraform-provider-git/internal/modifiers"
 )
 
-type CloneResource struct{}
+type CloneResource struct {
+	defaults *GitProviderModel
+}
 
 var (
-	_ resource.Resource               = (*CloneResource)(nil)
-	_ resource.ResourceWithModifyPlan = (*CloneResource)(nil)
+	_ resource.Resource                = (*CloneResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*CloneResource)(nil)
+	_ resource.ResourceWithConfigure   = (*CloneResource)(nil)
+	_ resource.ResourceWithImportState = (*CloneResource)(nil)
 )
 
-type cloneResourceModel struct {
-	Directory        types.String `tfsdk:"directory"`
-	Id               types.String `tfsdk:"id"`
-	Bare             types.Bool   `tfsdk:"bare"`
-	RemoteName       types.String `tfsdk:"remote_name"`
-	ReferenceName    types.String `tfsdk:"reference_name"`
-	URL              types.String `tfsdk:"url"`
-	InsecureSkipTls  types.Bool   `tfsdk:"insecure_skip_tls"`
-	CaBundleFilePath types.String `tfsdk:"ca_bundle_file_path"`
-	Auth             types.Object `tfsdk:"auth"`
-	SHA1             types.String `tfsdk:"sha1"`
+type CloneResourceModel struct {
+	Directory         types.String `tfsdk:"directory"`
+	Id                types.String `tfsdk:"id"`
+	Bare              types.Bool   `tfsdk:"bare"`
+	RemoteName        types.String `tfsdk:"remote_name"`
+	ReferenceName     types.String `tfsdk:"reference_name"`
+	URL               types.String `tfsdk:"url"`
+	Depth             types.Int64  `tfsdk:"depth"`
+	SingleBranch      types.Bool   `tfsdk:"single_branch"`
+	NoCheckout        types.Bool   `tfsdk:"no_checkout"`
+	Tags              types.String `tfsdk:"tags"`
+	RecurseSubmodules types.Bool   `tfsdk:"recurse_submodules"`
+	ShallowSubmodules types.Bool   `tfsdk:"shallow_submodules"`
+	InsecureSkipTls   types.Bool   `tfsdk:"insecure_skip_tls"`
+	CaBundleFilePath  types.String `tfsdk:"ca_bundle_file_path"`
+	Auth              types.Object `tfsdk:"auth"`
+	SHA1              types.String `tfsdk:"sha1"`
 }
 
 func NewCloneResource() resource.Resource {
@@ -60,6 +68,10 @@ func (r *CloneResource) Metadata(_ context.Context, req resource.MetadataRequest
 	resp.TypeName = req.ProviderTypeName + "_clone"
 }
 
+func (r *CloneResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.defaults = providerDefaults(req.ProviderData, &resp.Diagnostics)
+}
+
 func (r *CloneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description:         "Clones a Git repository similar to 'git clone'.",
@@ -128,9 +140,22 @@ func (r *CloneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"insecure_skip_tls": schema.BoolAttribute{
-				Description:         "Skip SSL verification if protocol is HTTPS.",
-				MarkdownDescription: "Skip SSL verification if protocol is HTTPS.",
+			"depth": schema.Int64Attribute{
+				Description:         "Create a shallow clone with a history truncated to the specified number of commits. Defaults to '0' which clones the full history.",
+				MarkdownDescription: "Create a shallow clone with a history truncated to the specified number of commits. Defaults to `0` which clones the full history.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					modifiers.DefaultInt64(0),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"single_branch": schema.BoolAttribute{
+				Description:         "Clone only the history leading to the tip of the branch specified in 'reference_name'. Defaults to 'false'.",
+				MarkdownDescription: "Clone only the history leading to the tip of the branch specified in `reference_name`. Defaults to `false`.",
 				Computed:            true,
 				Optional:            true,
 				PlanModifiers: []planmodifier.Bool{
@@ -138,250 +163,66 @@ func (r *CloneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					boolplanmodifier.RequiresReplace(),
 				},
 			},
-			"ca_bundle_file_path": schema.StringAttribute{
-				Description:         "File system path to an additional CA bundle to use together with the system cert pool.",
-				MarkdownDescription: "File system path to an additional CA bundle to use together with the system cert pool.",
+			"no_checkout": schema.BoolAttribute{
+				Description:         "Do not check out files into the worktree after the clone is complete. Defaults to 'false'.",
+				MarkdownDescription: "Do not check out files into the worktree after the clone is complete. Defaults to `false`.",
 				Computed:            true,
 				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags": schema.StringAttribute{
+				Description:         "Which tags to fetch from the remote. Possible values are 'all' to fetch all tags, 'none' to fetch no tags, and 'following' to fetch only tags that point to fetched commits. Defaults to 'all'.",
+				MarkdownDescription: "Which tags to fetch from the remote. Possible values are `all` to fetch all tags, `none` to fetch no tags, and `following` to fetch only tags that point to fetched commits. Defaults to `all`.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "none", "following"),
+				},
 				PlanModifiers: []planmodifier.String{
-					modifiers.DefaultString(""),
+					modifiers.DefaultString("all"),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"auth": schema.SingleNestedAttribute{
-				Description:         "The authentication credentials, if required, to use with the remote repository.",
-				MarkdownDescription: "The authentication credentials, if required, to use with the remote repository.",
+			"recurse_submodules": schema.BoolAttribute{
+				Description:         "Initialize and clone all submodules after the clone is complete. Defaults to 'false'.",
+				MarkdownDescription: "Initialize and clone all submodules after the clone is complete. Defaults to `false`.",
+				Computed:            true,
 				Optional:            true,
-				Attributes: map[string]schema.Attribute{
-					"basic": schema.SingleNestedAttribute{
-						Description:         "Configure basic auth authentication.",
-						MarkdownDescription: "Configure basic auth authentication.",
-						Optional:            true,
-						Attributes: map[string]schema.Attribute{
-							"username": schema.StringAttribute{
-								Description:         "The basic auth username.",
-								MarkdownDescription: "The basic auth username.",
-								Required:            true,
-								PlanModifiers: []planmodifier.String{
-									stringplanmodifier.RequiresReplace(),
-								},
-							},
-							"password": schema.StringAttribute{
-								Description:         "The basic auth password.",
-								MarkdownDescription: "The basic auth password.",
-								Required:            true,
-								PlanModifiers: []planmodifier.String{
-									stringplanmodifier.RequiresReplace(),
-								},
-							},
-						},
-						Validators: []validator.Object{
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("bearer")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_key")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_password")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_agent")),
-							objectvalidator.ExactlyOneOf(
-								path.MatchRelative().AtParent().AtName("basic"),
-								path.MatchRelative().AtParent().AtName("bearer"),
-								path.MatchRelative().AtParent().AtName("ssh_key"),
-								path.MatchRelative().AtParent().AtName("ssh_password"),
-								path.MatchRelative().AtParent().AtName("ssh_agent"),
-							),
-						},
-						PlanModifiers: []planmodifier.Object{
-							objectplanmodifier.RequiresReplace(),
-						},
-					},
-					"bearer": schema.StringAttribute{
-						Description:         "Configure HTTP bearer token authentication. Note that services like GitHub use basic auth with your OAuth2 personal access token as the password.",
-						MarkdownDescription: "Configure HTTP bearer token authentication. **Note**: Services like GitHub use basic auth with your OAuth2 personal access token as the password.",
-						Optional:            true,
-						Validators: []validator.String{
-							stringvalidator.LengthAtLeast(1),
-							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("basic")),
-							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_key")),
-							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_password")),
-							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_agent")),
-							stringvalidator.ExactlyOneOf(
-								path.MatchRelative().AtParent().AtName("basic"),
-								path.MatchRelative().AtParent().AtName("bearer"),
-								path.MatchRelative().AtParent().AtName("ssh_key"),
-								path.MatchRelative().AtParent().AtName("ssh_password"),
-								path.MatchRelative().AtParent().AtName("ssh_agent"),
-							),
-						},
-						PlanModifiers: []planmodifier.String{
-							stringplanmodifier.RequiresReplace(),
-						},
-					},
-					"ssh_key": schema.SingleNestedAttribute{
-						Description:         "Configure SSH public/private key authentication.",
-						MarkdownDescription: "Configure SSH public/private key authentication.",
-						Optional:            true,
-						Attributes: map[string]schema.Attribute{
-							"username": schema.StringAttribute{
-								Description:         "The SSH auth username.",
-								MarkdownDescription: "The SSH auth username.",
-								Optional:            true,
-								Computed:            true,
-								PlanModifiers: []planmodifier.String{
-									modifiers.DefaultString("git"),
-									stringplanmodifier.RequiresReplace(),
-								},
-							},
-							"password": schema.StringAttribute{
-								Description:         "The SSH key password.",
-								MarkdownDescription: "The SSH key password.",
-								Optional:            true,
-								Computed:            true,
-								PlanModifiers: []planmodifier.String{
-									modifiers.DefaultString(""),
-									stringplanmodifier.RequiresReplace(),
-								},
-							},
-							"private_key_path": schema.StringAttribute{
-								Description:         "The absolute path to the private SSH key.",
-								MarkdownDescription: "The absolute path to the private SSH key.",
-								Optional:            true,
-								Validators: []validator.String{
-									stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("private_key_pem")),
-									stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("private_key_pem")),
-								},
-								PlanModifiers: []planmodifier.String{
-									stringplanmodifier.RequiresReplace(),
-								},
-							},
-							"private_key_pem": schema.StringAttribute{
-								Description:         "The private SSH key in PEM format.",
-								MarkdownDescription: "The private SSH key in PEM format.",
-								Optional:            true,
-								Validators: []validator.String{
-									stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("private_key_path")),
-									stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("private_key_path")),
-								},
-								PlanModifiers: []planmodifier.String{
-									stringplanmodifier.RequiresReplace(),
-								},
-							},
-							"known_hosts": schema.SetAttribute{
-								Description:         "The list of known hosts files to accept. If none are specified, system defaults will be used.",
-								MarkdownDescription: "The list of known hosts files to accept. If none are specified, system defaults will be used.",
-								ElementType:         types.StringType,
-								Optional:            true,
-								PlanModifiers: []planmodifier.Set{
-									setplanmodifier.RequiresReplace(),
-								},
-							},
-						},
-						Validators: []validator.Object{
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("basic")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("bearer")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_password")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_agent")),
-							objectvalidator.ExactlyOneOf(
-								path.MatchRelative().AtParent().AtName("basic"),
-								path.MatchRelative().AtParent().AtName("bearer"),
-								path.MatchRelative().AtParent().AtName("ssh_key"),
-								path.MatchRelative().AtParent().AtName("ssh_password"),
-								path.MatchRelative().AtParent().AtName("ssh_agent"),
-							),
-						},
-					},
-					"ssh_password": schema.SingleNestedAttribute{
-						Description:         "Configure password based SSH authentication.",
-						MarkdownDescription: "Configure password based SSH authentication.",
-						Optional:            true,
-						Attributes: map[string]schema.Attribute{
-							"username": schema.StringAttribute{
-								Description:         "The SSH username.",
-								MarkdownDescription: "The SSH username.",
-								Required:            true,
-								PlanModifiers: []planmodifier.String{
-									stringplanmodifier.RequiresReplace(),
-								},
-							},
-							"password": schema.StringAttribute{
-								Description:         "The SSH password.",
-								MarkdownDescription: "The SSH password.",
-								Required:            true,
-								PlanModifiers: []planmodifier.String{
-									stringplanmodifier.RequiresReplace(),
-								},
-							},
-							"known_hosts": schema.SetAttribute{
-								Description:         "The list of known hosts files to accept. If none are specified, system defaults will be used.",
-								MarkdownDescription: "The list of known hosts files to accept. If none are specified, system defaults will be used.",
-								ElementType:         types.StringType,
-								Optional:            true,
-								PlanModifiers: []planmodifier.Set{
-									setplanmodifier.RequiresReplace(),
-								},
-							},
-						},
-						Validators: []validator.Object{
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("basic")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("bearer")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_key")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_agent")),
-							objectvalidator.ExactlyOneOf(
-								path.MatchRelative().AtParent().AtName("basic"),
-								path.MatchRelative().AtParent().AtName("bearer"),
-								path.MatchRelative().AtParent().AtName("ssh_key"),
-								path.MatchRelative().AtParent().AtName("ssh_password"),
-								path.MatchRelative().AtParent().AtName("ssh_agent"),
-							),
-						},
-						PlanModifiers: []planmodifier.Object{
-							objectplanmodifier.RequiresReplace(),
-						},
-					},
-					"ssh_agent": schema.SingleNestedAttribute{
-						Description:         "Configure SSH agent based authentication.",
-						MarkdownDescription: "Configure SSH agent based authentication.",
-						Optional:            true,
-						Attributes: map[string]schema.Attribute{
-							"username": schema.StringAttribute{
-								Description:         "The system username of the user talking to the SSH agent. Use an empty string in order to automatically fetch this.",
-								MarkdownDescription: "The system username of the user talking to the SSH agent. Use an empty string in order to automatically fetch this.",
-								Optional:            true,
-								Computed:            true,
-								PlanModifiers: []planmodifier.String{
-									modifiers.DefaultString(""),
-									stringplanmodifier.RequiresReplace(),
-								},
-							},
-							"known_hosts": schema.SetAttribute{
-								Description:         "The list of known hosts files to accept. If none are specified, system defaults will be used.",
-								MarkdownDescription: "The list of known hosts files to accept. If none are specified, system defaults will be used.",
-								ElementType:         types.StringType,
-								Optional:            true,
-								PlanModifiers: []planmodifier.Set{
-									setplanmodifier.RequiresReplace(),
-								},
-							},
-						},
-						Validators: []validator.Object{
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("basic")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("bearer")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_key")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_password")),
-							objectvalidator.ExactlyOneOf(
-								path.MatchRelative().AtParent().AtName("basic"),
-								path.MatchRelative().AtParent().AtName("bearer"),
-								path.MatchRelative().AtParent().AtName("ssh_key"),
-								path.MatchRelative().AtParent().AtName("ssh_password"),
-								path.MatchRelative().AtParent().AtName("ssh_agent"),
-							),
-						},
-						PlanModifiers: []planmodifier.Object{
-							objectplanmodifier.RequiresReplace(),
-						},
-					},
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+					boolplanmodifier.RequiresReplace(),
 				},
-				PlanModifiers: []planmodifier.Object{
-					objectplanmodifier.RequiresReplace(),
+			},
+			"shallow_submodules": schema.BoolAttribute{
+				Description:         "Clone submodules with a history truncated to a single commit. Only used together with 'recurse_submodules'. Defaults to 'false'.",
+				MarkdownDescription: "Clone submodules with a history truncated to a single commit. Only used together with `recurse_submodules`. Defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"insecure_skip_tls": schema.BoolAttribute{
+				Description:         "Skip SSL verification if protocol is HTTPS. Defaults to the 'insecure_skip_tls' setting of the provider.",
+				MarkdownDescription: "Skip SSL verification if protocol is HTTPS. Defaults to the `insecure_skip_tls` setting of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.RequiresReplaceBoolUnlessUnset(false),
+				},
+			},
+			"ca_bundle_file_path": schema.StringAttribute{
+				Description:         "File system path to an additional CA bundle to use together with the system cert pool. Defaults to the 'ca_bundle_file_path' setting of the provider.",
+				MarkdownDescription: "File system path to an additional CA bundle to use together with the system cert pool. Defaults to the `ca_bundle_file_path` setting of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					modifiers.RequiresReplaceStringUnlessUnset(""),
 				},
 			},
+			"auth": authResourceAttribute(),
 			"sha1": schema.StringAttribute{
 				Description:         "The SHA1 hash of the created commit.",
 				MarkdownDescription: "The SHA1 hash of the created commit.",
@@ -397,8 +238,8 @@ func (r *CloneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 func (r *CloneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Debug(ctx, "Create resource git_clone")
 
-	var inputs cloneResourceModel
-	var state cloneResourceModel
+	var inputs CloneResourceModel
+	var state CloneResourceModel
 
 	diags := req.Plan.Get(ctx, &inputs)
 	resp.Diagnostics.Append(diags...)
@@ -409,7 +250,7 @@ func (r *CloneResource) Create(ctx context.Context, req resource.CreateRequest,
 	directory := inputs.Directory.ValueString()
 	bare := inputs.Bare.ValueBool()
 
-	options := CreateCloneOptions(ctx, &inputs, &resp.Diagnostics)
+	options := CreateCloneOptions(ctx, &inputs, r.defaults, &resp.Diagnostics)
 	if options == nil {
 		return
 	}
@@ -432,6 +273,12 @@ func (r *CloneResource) Create(ctx context.Context, req resource.CreateRequest,
 	state.Id = inputs.Directory
 	state.Bare = inputs.Bare
 	state.URL = inputs.URL
+	state.Depth = inputs.Depth
+	state.SingleBranch = inputs.SingleBranch
+	state.NoCheckout = inputs.NoCheckout
+	state.Tags = inputs.Tags
+	state.RecurseSubmodules = inputs.RecurseSubmodules
+	state.ShallowSubmodules = inputs.ShallowSubmodules
 	state.RemoteName = inputs.RemoteName
 	state.ReferenceName = inputs.ReferenceName
 	state.InsecureSkipTls = inputs.InsecureSkipTls
@@ -460,18 +307,108 @@ func (r *CloneResource) Create(ctx context.Context, req resource.CreateRequest,
 
 func (r *CloneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	tflog.Debug(ctx, "Read resource git_clone")
-	// NO-OP: All data is already in Terraform state
+
+	var state CloneResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := state.Directory.ValueString()
+
+	if _, err := os.Stat(directory); os.IsNotExist(err) {
+		// the clone was removed outside of Terraform, thus we have to clone again
+		tflog.Trace(ctx, "clone does not exist", map[string]interface{}{
+			"directory": directory,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	head, err := repository.Head()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Cannot read HEAD",
+			"Could not read HEAD of repository ["+directory+"] because of: "+err.Error(),
+		)
+		return
+	}
+	state.SHA1 = types.StringValue(head.Hash().String())
+
+	// the configured value is kept as long as it points to the checked out branch, no matter whether it was written
+	// in its short or fully qualified form
+	referenceName := state.ReferenceName.ValueString()
+	if head.Name() != plumbing.ReferenceName(referenceName) && head.Name() != plumbing.NewBranchReferenceName(referenceName) {
+		if head.Name().IsBranch() {
+			state.ReferenceName = types.StringValue(head.Name().Short())
+		} else {
+			state.ReferenceName = types.StringValue(head.Name().String())
+		}
+	}
+
+	remoteName := state.RemoteName.ValueString()
+	remote, err := repository.Remote(remoteName)
+	switch {
+	case errors.Is(err, git.ErrRemoteNotFound) || err == nil && len(remote.Config().URLs) == 0:
+		// the remote or its URL was removed by hand, thus we have to clone again
+		state.URL = types.StringNull()
+	case err != nil:
+		resp.Diagnostics.AddError(
+			"Cannot read remote",
+			"Could not read remote ["+remoteName+"] because of: "+err.Error(),
+		)
+		return
+	case remote.Config().URLs[0] != cloneURL(state.URL.ValueString()):
+		state.URL = types.StringValue(remote.Config().URLs[0])
+	}
+
+	tflog.Trace(ctx, "read clone", map[string]interface{}{
+		"directory": directory,
+		"head":      state.SHA1.ValueString(),
+		"reference": state.ReferenceName.ValueString(),
+		"url":       state.URL.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 func (r *CloneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	tflog.Debug(ctx, "Update resource git_clone")
-	// NO-OP: All data is already in Terraform state
+
+	var inputs CloneResourceModel
+	var state CloneResourceModel
+
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// all other attributes require replacement, thus only unsetting the TLS options ends up here
+	state.InsecureSkipTls = inputs.InsecureSkipTls
+	state.CaBundleFilePath = inputs.CaBundleFilePath
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *CloneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	tflog.Debug(ctx, "Delete resource git_clone")
 
-	var state cloneResourceModel
+	var state CloneResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -501,6 +438,96 @@ func (r *CloneResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+func (r *CloneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "ImportState resource git_clone")
+
+	id := req.ID
+	idParts := strings.Split(id, "|")
+
+	if len(idParts) > 2 || idParts[0] == "" || (len(idParts) == 2 && idParts[1] == "") {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: 'path/to/your/git/repository|name-of-your-remote' Got: %q", id),
+		)
+		return
+	}
+
+	directory := idParts[0]
+	remoteName := "origin"
+	if len(idParts) == 2 {
+		remoteName = idParts[1]
+	}
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	worktree, err := getWorktree(repository, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	remote := getRemote(ctx, repository, remoteName, &resp.Diagnostics)
+	if remote == nil {
+		return
+	}
+	if len(remote.Config().URLs) == 0 {
+		resp.Diagnostics.AddError(
+			"Cannot import remote without URL",
+			"The remote ["+remoteName+"] of the repository at ["+directory+"] has no URL. Configure the URL the repository was cloned from before importing it.",
+		)
+		return
+	}
+
+	head, err := repository.Head()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Cannot read HEAD",
+			"Could not read HEAD of repository ["+directory+"] because of: "+err.Error(),
+		)
+		return
+	}
+	if !head.Name().IsBranch() {
+		resp.Diagnostics.AddError(
+			"Cannot import detached HEAD",
+			"The repository at ["+directory+"] has no branch checked out. Check out the branch that was cloned before importing it.",
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "importing clone", map[string]interface{}{
+		"directory": directory,
+		"remote":    remoteName,
+		"branch":    head.Name().Short(),
+		"head":      head.Hash().String(),
+	})
+
+	var state CloneResourceModel
+	state.Directory = types.StringValue(directory)
+	state.Id = types.StringValue(directory)
+	state.Bare = types.BoolValue(worktree == nil)
+	state.URL = types.StringValue(remote.Config().URLs[0])
+	state.RemoteName = types.StringValue(remoteName)
+	state.ReferenceName = types.StringValue(head.Name().Short())
+	state.Depth = types.Int64Value(0)
+	state.SingleBranch = types.BoolValue(false)
+	state.NoCheckout = types.BoolValue(false)
+	state.Tags = types.StringValue("all")
+	state.RecurseSubmodules = types.BoolValue(false)
+	state.ShallowSubmodules = types.BoolValue(false)
+	state.InsecureSkipTls = types.BoolNull()
+	state.CaBundleFilePath = types.StringNull()
+	state.Auth = types.ObjectNull(authResourceAttribute().GetType().(types.ObjectType).AttrTypes)
+	state.SHA1 = types.StringValue(head.Hash().String())
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
 func (r *CloneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	tflog.Debug(ctx, "ModifyPlan resource git_clone")
 
@@ -514,7 +541,7 @@ func (r *CloneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 		return
 	}
 
-	var inputs cloneResourceModel
+	var inputs CloneResourceModel
 	diags := req.Config.Get(ctx, &inputs)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -522,7 +549,7 @@ func (r *CloneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 	}
 
 	directory := inputs.Directory.ValueString()
-	url := inputs.URL.ValueString()
+	url := cloneURL(inputs.URL.ValueString())
 
 	repository := openRepository(ctx, directory, &resp.Diagnostics)
 	if repository == nil {
@@ -540,40 +567,25 @@ func (r *CloneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 
 	localHeadHash := head.Hash()
 
-	if runtime.GOOS == "windows" {
-		url = strings.ReplaceAll(url, "/", `\`)
-	}
-	remote := git.NewRemote(memory.NewStorage(), &config.RemoteConfig{
-		Name: "origin",
-		URLs: []string{url},
-	})
-	refs, err := remote.List(&git.ListOptions{
+	refs := listRemoteReferences(ctx, url, &git.ListOptions{
 		PeelingOption: git.AppendPeeled,
-		Auth:          authOptions(ctx, inputs.Auth, &diags),
-	})
-	if err != nil {
-		diags.AddError(
-			"Cannot list remote",
-			"Could not list remote of git repository ["+directory+"] because of: "+err.Error(),
-		)
+		Auth:          authOptions(ctx, inputs.Auth, r.defaults, &diags),
+	}, &diags)
+	if refs == nil {
 		return
 	}
 
-	for _, ref := range refs {
-		if ref.Name().IsBranch() {
-			var expectedRefName string
-			if inputs.ReferenceName.ValueString() == "" {
-				expectedRefName = "refs/heads/main"
-			} else {
-				expectedRefName = fmt.Sprintf("refs/heads/%s", inputs.ReferenceName.ValueString())
-			}
-			if expectedRefName == ref.Name().String() {
-				if localHeadHash.String() != ref.Hash().String() {
-					sha1 := path.Root("sha1")
-					resp.Plan.SetAttribute(ctx, sha1, ref.Hash().String())
-					resp.RequiresReplace = append(resp.RequiresReplace, sha1)
-				}
-			}
-		}
+	var expectedRefName plumbing.ReferenceName
+	if inputs.ReferenceName.ValueString() == "" {
+		expectedRefName = plumbing.NewBranchReferenceName("main")
+	} else {
+		expectedRefName = plumbing.NewBranchReferenceName(inputs.ReferenceName.ValueString())
+	}
+	// only the tips are compared since shallow clones lack the history required for ancestry checks
+	remoteRef := findRemoteReference(refs, expectedRefName)
+	if remoteRef != nil && localHeadHash != remoteRef.Hash() {
+		sha1 := path.Root("sha1")
+		resp.Plan.SetAttribute(ctx, sha1, remoteRef.Hash().String())
+		resp.RequiresReplace = append(resp.RequiresReplace, sha1)
 	}
 }
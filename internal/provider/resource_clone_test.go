@@ -7,12 +7,17 @@ package provider_test
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"regexp"
 	"testing"
 
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/metio/terraform-provider-git/internal/testutils"
 )
@@ -142,6 +147,123 @@ func TestResourceGitClone_MultipleCommits(t *testing.T) {
 	})
 }
 
+func TestResourceGitClone_Shallow(t *testing.T) {
+	t.Parallel()
+	directory := testutils.TemporaryDirectory(t)
+	localRepository, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.CreateTag(t, repository, "v1.0.0")
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	head := testutils.GetRepositoryHead(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_clone" "test" {
+						directory      = "%s"
+						url            = "%s"
+						reference_name = "master"
+						depth          = 1
+						single_branch  = true
+						no_checkout    = true
+						tags           = "none"
+					}
+				`, directory, localRepository),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("git_clone.test", tfjsonpath.New("sha1"), knownvalue.StringExact(head.Hash().String())),
+					statecheck.ExpectKnownValue("git_clone.test", tfjsonpath.New("depth"), knownvalue.Int64Exact(1)),
+					statecheck.ExpectKnownValue("git_clone.test", tfjsonpath.New("single_branch"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("git_clone.test", tfjsonpath.New("no_checkout"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("git_clone.test", tfjsonpath.New("tags"), knownvalue.StringExact("none")),
+				},
+			},
+			{
+				PreConfig: func() {
+					testutils.AddAndCommitNewFile(t, worktree, "third-file")
+				},
+				Config: fmt.Sprintf(`
+					resource "git_clone" "test" {
+						directory      = "%s"
+						url            = "%s"
+						reference_name = "master"
+						depth          = 1
+						single_branch  = true
+						no_checkout    = true
+						tags           = "none"
+					}
+				`, directory, localRepository),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("git_clone.test", tfjsonpath.New("sha1"), knownvalue.StringFunc(testutils.CheckExactLength(40))),
+				},
+			},
+		},
+	})
+}
+
+func TestResourceGitClone_RecurseSubmodules(t *testing.T) {
+	t.Parallel()
+	directory := testutils.TemporaryDirectory(t)
+	submoduleDirectory, submoduleRepository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, submoduleRepository)
+	testutils.AddAndCommitNewFile(t, testutils.GetRepositoryWorktree(t, submoduleRepository), "some-file")
+	submoduleHead := testutils.GetRepositoryHead(t, submoduleRepository)
+	localRepository, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	testutils.AddSubmodule(t, repository, "library", "vendor/library", submoduleDirectory, submoduleHead.Hash())
+	testutils.GitCommit(t, testutils.GetRepositoryWorktree(t, repository))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_clone" "test" {
+						directory          = "%s"
+						url                = "%s"
+						reference_name     = "master"
+						recurse_submodules = true
+					}
+
+					data "git_submodules" "test" {
+						directory = git_clone.test.directory
+					}
+				`, directory, localRepository),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("git_clone.test", tfjsonpath.New("recurse_submodules"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("git_clone.test", tfjsonpath.New("shallow_submodules"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("data.git_submodules.test", tfjsonpath.New("submodules").AtMapKey("library").AtMapKey("actual_sha1"), knownvalue.StringExact(submoduleHead.Hash().String())),
+				},
+			},
+		},
+	})
+}
+
+func TestResourceGitClone_Tags_Invalid(t *testing.T) {
+	t.Parallel()
+	directory := testutils.TemporaryDirectory(t)
+	localRepository, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_clone" "test" {
+						directory = "%s"
+						url       = "%s"
+						tags      = "some"
+					}
+				`, directory, localRepository),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
 func TestResourceGitClone_Directory_Missing(t *testing.T) {
 	t.Parallel()
 
@@ -177,3 +299,343 @@ func TestResourceGitClone_URL_Missing(t *testing.T) {
 		},
 	})
 }
+
+func TestResourceGitClone_Import(t *testing.T) {
+	t.Parallel()
+	localRepository, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	head := testutils.GetRepositoryHead(t, repository)
+	directory, _ := testutils.GitClone(t, localRepository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_clone" "test" {
+						directory      = "%s"
+						url            = "%s"
+						reference_name = "master"
+					}
+				`, directory, localRepository),
+				ResourceName:       "git_clone.test",
+				ImportState:        true,
+				ImportStateId:      directory,
+				ImportStatePersist: true,
+				ImportStateCheck: testutils.ComposeImportStateCheck(
+					testutils.CheckResourceAttrInstanceState("directory", directory),
+					testutils.CheckResourceAttrInstanceState("id", directory),
+					testutils.CheckResourceAttrInstanceState("url", localRepository),
+					testutils.CheckResourceAttrInstanceState("remote_name", "origin"),
+					testutils.CheckResourceAttrInstanceState("reference_name", "master"),
+					testutils.CheckResourceAttrInstanceState("bare", "false"),
+					testutils.CheckResourceAttrInstanceState("sha1", head.Hash().String()),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_clone" "test" {
+						directory      = "%s"
+						url            = "%s"
+						reference_name = "master"
+					}
+				`, directory, localRepository),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceGitClone_Import_RemoteName(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	url := "https://example.com/metio/terraform-provider-git.git"
+	testutils.CreateRemoteWithUrls(t, repository, "upstream", []string{url})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_clone" "test" {
+						directory      = "%s"
+						url            = "%s"
+						remote_name    = "upstream"
+						reference_name = "master"
+					}
+				`, directory, url),
+				ResourceName:       "git_clone.test",
+				ImportState:        true,
+				ImportStateId:      fmt.Sprintf("%s|%s", directory, "upstream"),
+				ImportStatePersist: false,
+				ImportStateCheck: testutils.ComposeImportStateCheck(
+					testutils.CheckResourceAttrInstanceState("id", directory),
+					testutils.CheckResourceAttrInstanceState("url", url),
+					testutils.CheckResourceAttrInstanceState("remote_name", "upstream"),
+					testutils.CheckResourceAttrInstanceState("reference_name", "master"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitClone_Import_NonExistingRemote(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_clone" "test" {
+						directory = "%s"
+						url       = "https://example.com/metio/terraform-provider-git.git"
+					}
+				`, directory),
+				ResourceName:       "git_clone.test",
+				ImportState:        true,
+				ImportStateId:      directory,
+				ImportStatePersist: false,
+				ExpectError:        regexp.MustCompile(`Cannot read remote`),
+			},
+		},
+	})
+}
+
+func TestResourceGitClone_Import_RemoteWithoutURL(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.AppendConfig(t, directory, "[remote \"origin\"]\n\tfetch = +refs/heads/*:refs/remotes/origin/*\n")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_clone" "test" {
+						directory = "%s"
+						url       = "https://example.com/metio/terraform-provider-git.git"
+					}
+				`, directory),
+				ResourceName:       "git_clone.test",
+				ImportState:        true,
+				ImportStateId:      directory,
+				ImportStatePersist: false,
+				ExpectError:        regexp.MustCompile(`Cannot import remote without URL`),
+			},
+		},
+	})
+}
+
+func TestResourceGitClone_DirectoryRemoved(t *testing.T) {
+	t.Parallel()
+	directory := testutils.TemporaryDirectory(t)
+	localRepository, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	head := testutils.GetRepositoryHead(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_clone" "test" {
+						directory      = "%s"
+						url            = "%s"
+						reference_name = "master"
+					}
+				`, directory, localRepository),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("git_clone.test", tfjsonpath.New("sha1"), knownvalue.StringExact(head.Hash().String())),
+				},
+			},
+			{
+				PreConfig: func() {
+					if err := os.RemoveAll(directory); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: fmt.Sprintf(`
+					resource "git_clone" "test" {
+						directory      = "%s"
+						url            = "%s"
+						reference_name = "master"
+					}
+				`, directory, localRepository),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("git_clone.test", tfjsonpath.New("sha1"), knownvalue.StringExact(head.Hash().String())),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(_ *terraform.State) error {
+						_, err := git.PlainOpen(directory)
+						return err
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitClone_RemoteURLChanged(t *testing.T) {
+	t.Parallel()
+	directory := testutils.TemporaryDirectory(t)
+	localRepository, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_clone" "test" {
+						directory      = "%s"
+						url            = "%s"
+						reference_name = "master"
+					}
+				`, directory, localRepository),
+			},
+			{
+				PreConfig: func() {
+					clone, err := git.PlainOpen(directory)
+					if err != nil {
+						t.Fatal(err)
+					}
+					cfg := testutils.ReadConfig(t, clone)
+					cfg.Remotes["origin"].URLs = []string{"https://example.com/metio/terraform-provider-git.git"}
+					testutils.WriteConfig(t, clone, cfg)
+				},
+				Config: fmt.Sprintf(`
+					resource "git_clone" "test" {
+						directory      = "%s"
+						url            = "%s"
+						reference_name = "master"
+					}
+				`, directory, localRepository),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_clone" "test" {
+						directory      = "%s"
+						url            = "%s"
+						reference_name = "master"
+					}
+					data "git_remote" "test" {
+						directory  = "%s"
+						name       = "origin"
+						depends_on = [git_clone.test]
+					}
+				`, directory, localRepository, directory),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("git_clone.test", tfjsonpath.New("url"), knownvalue.StringExact(localRepository)),
+					statecheck.ExpectKnownValue("data.git_remote.test", tfjsonpath.New("urls"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact(localRepository),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestResourceGitClone_RemoteURLRemoved(t *testing.T) {
+	t.Parallel()
+	directory := testutils.TemporaryDirectory(t)
+	localRepository, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_clone" "test" {
+						directory      = "%s"
+						url            = "%s"
+						reference_name = "master"
+					}
+				`, directory, localRepository),
+			},
+			{
+				PreConfig: func() {
+					configPath := filepath.Join(directory, ".git", "config")
+					content, err := os.ReadFile(configPath)
+					if err != nil {
+						t.Fatal(err)
+					}
+					content = regexp.MustCompile(`(?m)^\s*url = .*\n`).ReplaceAll(content, nil)
+					if err = os.WriteFile(configPath, content, 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: fmt.Sprintf(`
+					resource "git_clone" "test" {
+						directory      = "%s"
+						url            = "%s"
+						reference_name = "master"
+					}
+				`, directory, localRepository),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestResourceGitClone_ReferenceChanged(t *testing.T) {
+	t.Parallel()
+	directory := testutils.TemporaryDirectory(t)
+	localRepository, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_clone" "test" {
+						directory      = "%s"
+						url            = "%s"
+						reference_name = "master"
+					}
+				`, directory, localRepository),
+			},
+			{
+				PreConfig: func() {
+					clone, err := git.PlainOpen(directory)
+					if err != nil {
+						t.Fatal(err)
+					}
+					err = testutils.GetRepositoryWorktree(t, clone).Checkout(&git.CheckoutOptions{
+						Branch: plumbing.NewBranchReferenceName("other"),
+						Create: true,
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: fmt.Sprintf(`
+					resource "git_clone" "test" {
+						directory      = "%s"
+						url            = "%s"
+						reference_name = "master"
+					}
+				`, directory, localRepository),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
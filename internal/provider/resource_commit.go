@@ -7,6 +7,8 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-git/go-git/v5"
@@ -17,7 +19,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -26,11 +27,15 @@ import (
 	"github.com/metio/terraform-provider-git/internal/modifiers"
 )
 
-type CommitResource struct{}
+type CommitResource struct {
+	defaults *GitProviderModel
+}
 
 var (
-	_ resource.Resource               = (*CommitResource)(nil)
-	_ resource.ResourceWithModifyPlan = (*CommitResource)(nil)
+	_ resource.Resource                = (*CommitResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*CommitResource)(nil)
+	_ resource.ResourceWithConfigure   = (*CommitResource)(nil)
+	_ resource.ResourceWithImportState = (*CommitResource)(nil)
 )
 
 type commitResourceModel struct {
@@ -41,6 +46,7 @@ type commitResourceModel struct {
 	AllowEmptyCommits types.Bool   `tfsdk:"allow_empty_commits"`
 	Author            types.Object `tfsdk:"author"`
 	Committer         types.Object `tfsdk:"committer"`
+	Signing           types.Object `tfsdk:"signing"`
 	SHA1              types.String `tfsdk:"sha1"`
 	Files             types.List   `tfsdk:"files"`
 }
@@ -53,6 +59,10 @@ func (r *CommitResource) Metadata(_ context.Context, req resource.MetadataReques
 	resp.TypeName = req.ProviderTypeName + "_commit"
 }
 
+func (r *CommitResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.defaults = providerDefaults(req.ProviderData, &resp.Diagnostics)
+}
+
 func (r *CommitResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Record changes to the repository similar to 'git commit'. Note that configuration changes to this resource which cause a replacement will create a new commit and keep the previous commit as-is.",
@@ -109,70 +119,9 @@ func (r *CommitResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					boolplanmodifier.RequiresReplace(),
 				},
 			},
-			"author": schema.SingleNestedAttribute{
-				Description:         "The original author of the commit. If none is specified, the author will be read from the Git configuration.",
-				MarkdownDescription: "The original author of the commit. If none is specified, the author will be read from the Git configuration.",
-				Computed:            true,
-				Optional:            true,
-				Attributes: map[string]schema.Attribute{
-					"name": schema.StringAttribute{
-						Description:         "The name of the author.",
-						MarkdownDescription: "The name of the author.",
-						Computed:            true,
-						Optional:            true,
-						PlanModifiers: []planmodifier.String{
-							stringplanmodifier.UseStateForUnknown(),
-							stringplanmodifier.RequiresReplace(),
-						},
-					},
-					"email": schema.StringAttribute{
-						Description:         "The email address of the author.",
-						MarkdownDescription: "The email address of the author.",
-						Computed:            true,
-						Optional:            true,
-						PlanModifiers: []planmodifier.String{
-							stringplanmodifier.UseStateForUnknown(),
-							stringplanmodifier.RequiresReplace(),
-						},
-					},
-				},
-				PlanModifiers: []planmodifier.Object{
-					objectplanmodifier.UseStateForUnknown(),
-					objectplanmodifier.RequiresReplace(),
-				},
-			},
-			"committer": schema.SingleNestedAttribute{
-				Description:         "The person performing the commit. If none is specified, the author is used as committer.",
-				MarkdownDescription: "The person performing the commit. If none is specified, the author is used as committer.",
-				Computed:            true,
-				Optional:            true,
-				Attributes: map[string]schema.Attribute{
-					"name": schema.StringAttribute{
-						Description:         "The name of the committer.",
-						MarkdownDescription: "The name of the committer.",
-						Computed:            true,
-						Optional:            true,
-						PlanModifiers: []planmodifier.String{
-							stringplanmodifier.UseStateForUnknown(),
-							stringplanmodifier.RequiresReplace(),
-						},
-					},
-					"email": schema.StringAttribute{
-						Description:         "The email address of the committer.",
-						MarkdownDescription: "The email address of the committer.",
-						Computed:            true,
-						Optional:            true,
-						PlanModifiers: []planmodifier.String{
-							stringplanmodifier.UseStateForUnknown(),
-							stringplanmodifier.RequiresReplace(),
-						},
-					},
-				},
-				PlanModifiers: []planmodifier.Object{
-					objectplanmodifier.UseStateForUnknown(),
-					objectplanmodifier.RequiresReplace(),
-				},
-			},
+			"author":    authorResourceAttribute(),
+			"committer": committerResourceAttribute(),
+			"signing":   signingResourceAttribute(),
 			"sha1": schema.StringAttribute{
 				Description:         "The SHA1 hash of the created commit.",
 				MarkdownDescription: "The SHA1 hash of the created commit.",
@@ -236,11 +185,15 @@ func (r *CommitResource) Create(ctx context.Context, req resource.CreateRequest,
 	state.Message = inputs.Message
 	state.Author = signatureObject(&inputs.Author)
 	state.Committer = signatureObject(&inputs.Committer)
+	state.Signing = inputs.Signing
 	state.Files = types.ListNull(types.StringType)
 	state.SHA1 = types.StringNull()
 
 	if !status.IsClean() {
-		options := createCommitOptions(ctx, inputs)
+		options := createCommitOptions(ctx, inputs, r.defaults, &resp.Diagnostics)
+		if options == nil {
+			return
+		}
 
 		hash := createCommit(worktree, inputs.Message.ValueString(), options, &resp.Diagnostics)
 		if hash == nil {
@@ -280,6 +233,57 @@ func (r *CommitResource) Delete(ctx context.Context, _ resource.DeleteRequest, _
 	// NO-OP: Terraform removes the state automatically for us
 }
 
+func (r *CommitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "ImportState resource git_commit")
+
+	id := req.ID
+	idParts := strings.Split(id, "|")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: 'path/to/your/git/repository|sha1-of-your-commit' Got: %q", id),
+		)
+		return
+	}
+
+	directory := idParts[0]
+	revision := idParts[1]
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	hash := resolveRevision(ctx, repository, revision, &resp.Diagnostics)
+	if hash == nil {
+		return
+	}
+
+	commitObject := getCommit(ctx, repository, hash, &resp.Diagnostics)
+	if commitObject == nil {
+		return
+	}
+
+	var state commitResourceModel
+	state.Directory = types.StringValue(directory)
+	state.Id = types.Int64Value(commitObject.Committer.When.UnixNano())
+	state.Message = types.StringValue(strings.TrimSpace(commitObject.Message))
+	state.All = types.BoolValue(false)
+	state.AllowEmptyCommits = types.BoolValue(true)
+	state.Author = signatureToObjectWithoutTimestamp(&commitObject.Author)
+	state.Committer = signatureToObjectWithoutTimestamp(&commitObject.Committer)
+	state.Signing = types.ObjectNull(signingResourceAttribute().GetType().(types.ObjectType).AttrTypes)
+	state.Files, _ = types.ListValueFrom(ctx, types.StringType, extractModifiedFiles(commitObject))
+	state.SHA1 = types.StringValue(commitObject.Hash.String())
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
 func (r *CommitResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	tflog.Debug(ctx, "ModifyPlan resource git_commit")
 
@@ -721,3 +721,268 @@ func TestResourceGitCommit_WithStagedChanges_AllEnabled(t *testing.T) {
 		},
 	})
 }
+
+func TestResourceGitCommit_Author_ProviderDefaults(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	name := "some-file"
+	testutils.WriteFileInWorktree(t, worktree, name)
+	testutils.GitAdd(t, worktree, name)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					provider "git" {
+						author = {
+							name  = "provider author"
+							email = "provider-author@example.com"
+						}
+						committer = {
+							name  = "provider committer"
+							email = "provider-committer@example.com"
+						}
+					}
+					resource "git_commit" "test" {
+						directory = "%s"
+						message   = "committed with terraform"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_commit.test", "author.name", "provider author"),
+					resource.TestCheckResourceAttr("git_commit.test", "author.email", "provider-author@example.com"),
+					resource.TestCheckResourceAttr("git_commit.test", "committer.name", "provider committer"),
+					resource.TestCheckResourceAttr("git_commit.test", "committer.email", "provider-committer@example.com"),
+					resource.TestCheckResourceAttrWith("git_commit.test", "sha1", testutils.CheckExactLength(40)),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitCommit_Author_ProviderDefaults_Overridden(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	name := "some-file"
+	testutils.WriteFileInWorktree(t, worktree, name)
+	testutils.GitAdd(t, worktree, name)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					provider "git" {
+						author = {
+							name  = "provider author"
+							email = "provider-author@example.com"
+						}
+					}
+					resource "git_commit" "test" {
+						directory = "%s"
+						message   = "committed with terraform"
+						author = {
+							name  = "resource author"
+							email = "resource-author@example.com"
+						}
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_commit.test", "author.name", "resource author"),
+					resource.TestCheckResourceAttr("git_commit.test", "author.email", "resource-author@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitCommit_Signing_OpenPGP(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	name := "some-file"
+	testutils.WriteFileInWorktree(t, worktree, name)
+	testutils.GitAdd(t, worktree, name)
+	_, privateKey, publicKey := testutils.OpenPGPKey(t, "secret")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_commit" "test" {
+						directory = "%s"
+						message   = "committed with terraform"
+						signing   = {
+							private_key_pem = <<EOT
+%sEOT
+							passphrase      = "secret"
+						}
+					}
+
+					data "git_commit" "test" {
+						directory = git_commit.test.directory
+						revision  = git_commit.test.sha1
+						keyring   = <<EOT
+%sEOT
+					}
+				`, directory, privateKey, publicKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("git_commit.test", "sha1", testutils.CheckExactLength(40)),
+					resource.TestCheckResourceAttrWith("data.git_commit.test", "signature", testutils.CheckMinLength(1)),
+					resource.TestCheckResourceAttr("data.git_commit.test", "verified", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitCommit_Signing_SSH(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	name := "some-file"
+	testutils.WriteFileInWorktree(t, worktree, name)
+	testutils.GitAdd(t, worktree, name)
+	privateKey, _ := testutils.SSHKey(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_commit" "test" {
+						directory = "%s"
+						message   = "committed with terraform"
+						signing   = {
+							format          = "ssh"
+							private_key_pem = <<EOT
+%sEOT
+						}
+					}
+
+					data "git_commit" "test" {
+						directory = git_commit.test.directory
+						revision  = git_commit.test.sha1
+					}
+				`, directory, privateKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("git_commit.test", "sha1", testutils.CheckExactLength(40)),
+					resource.TestMatchResourceAttr("data.git_commit.test", "signature", regexp.MustCompile(`^-----BEGIN SSH SIGNATURE-----`)),
+					resource.TestCheckNoResourceAttr("data.git_commit.test", "verified"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitCommit_Signing_WrongPassphrase(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	name := "some-file"
+	testutils.WriteFileInWorktree(t, worktree, name)
+	testutils.GitAdd(t, worktree, name)
+	_, privateKey, _ := testutils.OpenPGPKey(t, "secret")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_commit" "test" {
+						directory = "%s"
+						message   = "committed with terraform"
+						signing   = {
+							private_key_pem = <<EOT
+%sEOT
+							passphrase      = "wrong"
+						}
+					}
+				`, directory, privateKey),
+				ExpectError: regexp.MustCompile(`Cannot decrypt signing key`),
+			},
+		},
+	})
+}
+
+func TestResourceGitCommit_Import(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	name := "some-file"
+	testutils.WriteFileInWorktree(t, worktree, name)
+	testutils.GitAdd(t, worktree, name)
+	hash := testutils.GitCommit(t, worktree)
+	signature := testutils.Signature()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_commit" "test" {
+						directory = "%s"
+						message   = "example go-git commit"
+					}
+				`, directory),
+				ResourceName:       "git_commit.test",
+				ImportState:        true,
+				ImportStateId:      fmt.Sprintf("%s|%s", directory, hash.String()),
+				ImportStatePersist: true,
+				ImportStateCheck: testutils.ComposeImportStateCheck(
+					testutils.CheckResourceAttrInstanceState("directory", directory),
+					testutils.CheckResourceAttrInstanceState("message", "example go-git commit"),
+					testutils.CheckResourceAttrInstanceState("all", "false"),
+					testutils.CheckResourceAttrInstanceState("allow_empty_commits", "true"),
+					testutils.CheckResourceAttrInstanceState("author.name", signature.Name),
+					testutils.CheckResourceAttrInstanceState("author.email", signature.Email),
+					testutils.CheckResourceAttrInstanceState("committer.name", signature.Name),
+					testutils.CheckResourceAttrInstanceState("committer.email", signature.Email),
+					testutils.CheckResourceAttrInstanceState("sha1", hash.String()),
+					testutils.CheckResourceAttrInstanceState("files.#", "1"),
+					testutils.CheckResourceAttrInstanceState("files.0", name),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_commit" "test" {
+						directory = "%s"
+						message   = "example go-git commit"
+					}
+				`, directory),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceGitCommit_Import_InvalidIdentifier(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_commit" "test" {
+						directory = "%s"
+						message   = "example go-git commit"
+					}
+				`, directory),
+				ResourceName:       "git_commit.test",
+				ImportState:        true,
+				ImportStateId:      directory,
+				ImportStatePersist: false,
+				ExpectError:        regexp.MustCompile(`Unexpected import identifier`),
+			},
+		},
+	})
+}
@@ -0,0 +1,328 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/metio/terraform-provider-git/internal/modifiers"
+)
+
+type ConfigResource struct{}
+
+var (
+	_ resource.Resource                = (*ConfigResource)(nil)
+	_ resource.ResourceWithImportState = (*ConfigResource)(nil)
+)
+
+type configResourceModel struct {
+	Directory types.String `tfsdk:"directory"`
+	Id        types.String `tfsdk:"id"`
+	Scope     types.String `tfsdk:"scope"`
+	Key       types.String `tfsdk:"key"`
+	Value     types.String `tfsdk:"value"`
+	Values    types.List   `tfsdk:"values"`
+}
+
+func NewConfigResource() resource.Resource {
+	return &ConfigResource{}
+}
+
+func (r *ConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config"
+}
+
+func (r *ConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a single configuration key of a Git repository similar to 'git config'. Only the managed key is removed on destroy.",
+		MarkdownDescription: "Manages a single configuration key of a Git repository similar to `git config`. Only the managed key is removed on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"directory": schema.StringAttribute{
+				Description:         "The path to the local Git repository.",
+				MarkdownDescription: "The path to the local Git repository.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description:         "The import ID to import this resource which has the form 'directory|scope|key'",
+				MarkdownDescription: "The import ID to import this resource which has the form `'directory|scope|key'`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scope": schema.StringAttribute{
+				Description:         "The configuration scope to write. Possible values are 'local' and 'global'. Defaults to 'local'.",
+				MarkdownDescription: "The configuration scope to write. Possible values are `local` and `global`. Defaults to `local`.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("local", "global"),
+				},
+				PlanModifiers: []planmodifier.String{
+					modifiers.DefaultString("local"),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description:         "The configuration key in the form 'section.name' or 'section.subsection.name', e.g. 'core.hooksPath' or 'url.git@github.com:.insteadOf'.",
+				MarkdownDescription: "The configuration key in the form `section.name` or `section.subsection.name`, e.g. `core.hooksPath` or `url.git@github.com:.insteadOf`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(configKeyPattern, "must be in the form 'section.name' or 'section.subsection.name'"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description:         "The value of the key. Conflicts with 'values'.",
+				MarkdownDescription: "The value of the key. Conflicts with `values`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("values")),
+				},
+			},
+			"values": schema.ListAttribute{
+				Description:         "All values of a multi-valued key in the order they are written to the configuration. Conflicts with 'value'.",
+				MarkdownDescription: "All values of a multi-valued key in the order they are written to the configuration. Conflicts with `value`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *ConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create resource git_config")
+
+	var inputs configResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	writeConfigResource(ctx, inputs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state configResourceModel
+	state.Directory = inputs.Directory
+	state.Id = types.StringValue(fmt.Sprintf("%s|%s|%s", inputs.Directory.ValueString(), inputs.Scope.ValueString(), inputs.Key.ValueString()))
+	state.Scope = inputs.Scope
+	state.Key = inputs.Key
+	state.Value = inputs.Value
+	state.Values = inputs.Values
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Read resource git_config")
+
+	var state configResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repository := openRepository(ctx, state.Directory.ValueString(), &resp.Diagnostics)
+	if repository == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	raw := readRawConfig(ctx, repository, state.Scope.ValueString(), &resp.Diagnostics)
+	if raw == nil {
+		return
+	}
+
+	values := getConfigValues(raw, state.Key.ValueString())
+	if len(values) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var newState configResourceModel
+	newState.Directory = state.Directory
+	newState.Id = state.Id
+	newState.Scope = state.Scope
+	newState.Key = state.Key
+	newState.Value = types.StringNull()
+	newState.Values = types.ListNull(types.StringType)
+	if !state.Values.IsNull() {
+		newState.Values, diags = types.ListValueFrom(ctx, types.StringType, values)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else if len(values) == 1 {
+		// 'value' stays empty in case the key got additional values outside of Terraform, thus forcing an update
+		newState.Value = types.StringValue(values[0])
+	}
+
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Update resource git_config")
+
+	var inputs configResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	writeConfigResource(ctx, inputs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Delete resource git_config")
+
+	var state configResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repository := openRepository(ctx, state.Directory.ValueString(), &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	updateConfigFile(ctx, repository, state.Scope.ValueString(), []configChange{{key: state.Key.ValueString()}}, &resp.Diagnostics)
+}
+
+func (r *ConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "ImportState resource git_config")
+
+	id := req.ID
+	idParts := strings.Split(id, "|")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || !configKeyPattern.MatchString(idParts[2]) {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: 'path/to/your/git/repository|scope|section.name' Got: %q", id),
+		)
+		return
+	}
+
+	directory := idParts[0]
+	scope := idParts[1]
+	key := idParts[2]
+	tflog.Trace(ctx, "parsed import ID", map[string]interface{}{
+		"directory": directory,
+		"scope":     scope,
+		"key":       key,
+	})
+
+	if scope != "local" && scope != "global" {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			"The scope ["+scope+"] is not supported. Possible values are 'local' and 'global'.",
+		)
+		return
+	}
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	raw := readRawConfig(ctx, repository, scope, &resp.Diagnostics)
+	if raw == nil {
+		return
+	}
+
+	values := getConfigValues(raw, key)
+	if len(values) == 0 {
+		resp.Diagnostics.AddError(
+			"Cannot read config",
+			"The key ["+key+"] is not set in the "+scope+" config of ["+directory+"]",
+		)
+		return
+	}
+
+	var state configResourceModel
+	state.Directory = types.StringValue(directory)
+	state.Id = types.StringValue(id)
+	state.Scope = types.StringValue(scope)
+	state.Key = types.StringValue(key)
+	state.Value = types.StringNull()
+	state.Values = types.ListNull(types.StringType)
+	if len(values) == 1 {
+		state.Value = types.StringValue(values[0])
+	} else {
+		state.Values, _ = types.ListValueFrom(ctx, types.StringType, values)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// writeConfigResource replaces all values of the configured key with the planned value(s).
+func writeConfigResource(ctx context.Context, inputs configResourceModel, diag *diag.Diagnostics) {
+	repository := openRepository(ctx, inputs.Directory.ValueString(), diag)
+	if repository == nil {
+		return
+	}
+
+	values := []string{inputs.Value.ValueString()}
+	if !inputs.Values.IsNull() {
+		diag.Append(inputs.Values.ElementsAs(ctx, &values, false)...)
+		if diag.HasError() {
+			return
+		}
+	}
+
+	updateConfigFile(ctx, repository, inputs.Scope.ValueString(), []configChange{{key: inputs.Key.ValueString(), values: values}}, diag)
+}
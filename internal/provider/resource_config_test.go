@@ -0,0 +1,357 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/metio/terraform-provider-git/internal/testutils"
+)
+
+func TestResourceGitConfig(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_config" "test" {
+						directory = "%s"
+						key       = "core.hooksPath"
+						value     = "hooks"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_config.test", "directory", directory),
+					resource.TestCheckResourceAttr("git_config.test", "id", fmt.Sprintf("%s|local|core.hooksPath", directory)),
+					resource.TestCheckResourceAttr("git_config.test", "scope", "local"),
+					resource.TestCheckResourceAttr("git_config.test", "key", "core.hooksPath"),
+					resource.TestCheckResourceAttr("git_config.test", "value", "hooks"),
+					resource.TestCheckNoResourceAttr("git_config.test", "values"),
+					func(_ *terraform.State) error {
+						cfg := testutils.ReadConfig(t, repository)
+						if value := cfg.Raw.Section("core").Option("hooksPath"); value != "hooks" {
+							return fmt.Errorf("expected 'core.hooksPath' to be 'hooks', got %q", value)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_config" "test" {
+						directory = "%s"
+						key       = "core.hooksPath"
+						value     = "other"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_config.test", "value", "other"),
+					func(_ *terraform.State) error {
+						cfg := testutils.ReadConfig(t, repository)
+						if values := cfg.Raw.Section("core").OptionAll("hooksPath"); len(values) != 1 || values[0] != "other" {
+							return fmt.Errorf("expected 'core.hooksPath' to be 'other', got %q", values)
+						}
+						return nil
+					},
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			cfg := testutils.ReadConfig(t, repository)
+			if cfg.Raw.Section("core").HasOption("hooksPath") {
+				return fmt.Errorf("expected 'core.hooksPath' to be removed")
+			}
+			if !cfg.Raw.Section("core").HasOption("bare") {
+				return fmt.Errorf("expected 'core.bare' to be kept")
+			}
+			return nil
+		},
+	})
+}
+
+func TestResourceGitConfig_Values(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_config" "test" {
+						directory = "%s"
+						key       = "url.git@github.com:.insteadOf"
+						values    = ["https://github.com/", "gh:"]
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_config.test", "key", "url.git@github.com:.insteadOf"),
+					resource.TestCheckNoResourceAttr("git_config.test", "value"),
+					resource.TestCheckResourceAttr("git_config.test", "values.#", "2"),
+					resource.TestCheckResourceAttr("git_config.test", "values.0", "https://github.com/"),
+					resource.TestCheckResourceAttr("git_config.test", "values.1", "gh:"),
+					func(_ *terraform.State) error {
+						cfg := testutils.ReadConfig(t, repository)
+						values := cfg.Raw.Section("url").Subsection("git@github.com:").OptionAll("insteadOf")
+						if len(values) != 2 || values[0] != "https://github.com/" || values[1] != "gh:" {
+							return fmt.Errorf("unexpected values %q", values)
+						}
+						return nil
+					},
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			cfg := testutils.ReadConfig(t, repository)
+			if cfg.Raw.HasSection("url") {
+				return fmt.Errorf("expected empty 'url' section to be removed")
+			}
+			return nil
+		},
+	})
+}
+
+func TestResourceGitConfig_Drift(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_config" "test" {
+						directory = "%s"
+						key       = "pull.rebase"
+						value     = "true"
+					}
+				`, directory),
+			},
+			{
+				PreConfig: func() {
+					cfg := testutils.ReadConfig(t, repository)
+					cfg.Raw.Section("pull").AddOption("rebase", "false")
+					testutils.WriteConfig(t, repository, cfg)
+				},
+				Config: fmt.Sprintf(`
+					resource "git_config" "test" {
+						directory = "%s"
+						key       = "pull.rebase"
+						value     = "true"
+					}
+				`, directory),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_config" "test" {
+						directory = "%s"
+						key       = "pull.rebase"
+						value     = "true"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(_ *terraform.State) error {
+						cfg := testutils.ReadConfig(t, repository)
+						if values := cfg.Raw.Section("pull").OptionAll("rebase"); len(values) != 1 || values[0] != "true" {
+							return fmt.Errorf("unexpected values %q", values)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitConfig_Comments(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+	testutils.AppendConfig(t, directory, "# maintained by hand\n[pull]\n\t# only fast-forward\n\tff = only\n")
+	file := filepath.Join(directory, ".git", "config")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_config" "test" {
+						directory = "%s"
+						key       = "pull.rebase"
+						value     = "true"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(_ *terraform.State) error {
+						content, err := os.ReadFile(file)
+						if err != nil {
+							return err
+						}
+						expected := "# maintained by hand\n[pull]\n\t# only fast-forward\n\tff = only\n\trebase = true\n"
+						if !strings.Contains(string(content), expected) {
+							return fmt.Errorf("expected config to contain %q, got %q", expected, content)
+						}
+						return nil
+					},
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			content, err := os.ReadFile(file)
+			if err != nil {
+				return err
+			}
+			expected := "# maintained by hand\n[pull]\n\t# only fast-forward\n\tff = only\n"
+			if !strings.HasSuffix(string(content), expected) {
+				return fmt.Errorf("expected config to end with %q, got %q", expected, content)
+			}
+			return nil
+		},
+	})
+}
+
+func TestResourceGitConfig_Import(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	cfg := testutils.ReadConfig(t, repository)
+	cfg.Raw.Section("core").SetOption("hooksPath", "hooks")
+	testutils.WriteConfig(t, repository, cfg)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_config" "test" {
+						directory = "%s"
+						key       = "core.hooksPath"
+						value     = "hooks"
+					}
+				`, directory),
+				ResourceName:       "git_config.test",
+				ImportState:        true,
+				ImportStateId:      fmt.Sprintf("%s|local|core.hooksPath", directory),
+				ImportStatePersist: true,
+				ImportStateCheck: testutils.ComposeImportStateCheck(
+					testutils.CheckResourceAttrInstanceState("directory", directory),
+					testutils.CheckResourceAttrInstanceState("id", fmt.Sprintf("%s|local|core.hooksPath", directory)),
+					testutils.CheckResourceAttrInstanceState("scope", "local"),
+					testutils.CheckResourceAttrInstanceState("key", "core.hooksPath"),
+					testutils.CheckResourceAttrInstanceState("value", "hooks"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_config" "test" {
+						directory = "%s"
+						key       = "core.hooksPath"
+						value     = "hooks"
+					}
+				`, directory),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceGitConfig_Import_NonExistingKey(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_config" "test" {
+						directory = "%s"
+						key       = "core.hooksPath"
+						value     = "hooks"
+					}
+				`, directory),
+				ResourceName:       "git_config.test",
+				ImportState:        true,
+				ImportStateId:      fmt.Sprintf("%s|local|core.hooksPath", directory),
+				ImportStatePersist: false,
+				ExpectError:        regexp.MustCompile(`Cannot read config`),
+			},
+		},
+	})
+}
+
+func TestResourceGitConfig_Key_Invalid(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_config" "test" {
+						directory = "%s"
+						key       = "hooksPath"
+						value     = "hooks"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
+func TestResourceGitConfig_Value_Missing(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_config" "test" {
+						directory = "%s"
+						key       = "core.hooksPath"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestResourceGitConfig_Scope_Invalid(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_config" "test" {
+						directory = "%s"
+						scope     = "system"
+						key       = "core.hooksPath"
+						value     = "hooks"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
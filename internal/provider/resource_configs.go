@@ -0,0 +1,276 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/metio/terraform-provider-git/internal/modifiers"
+)
+
+type ConfigsResource struct{}
+
+var (
+	_ resource.Resource = (*ConfigsResource)(nil)
+)
+
+type configsResourceModel struct {
+	Directory types.String `tfsdk:"directory"`
+	Id        types.String `tfsdk:"id"`
+	Scope     types.String `tfsdk:"scope"`
+	Entries   types.Map    `tfsdk:"entries"`
+}
+
+func NewConfigsResource() resource.Resource {
+	return &ConfigsResource{}
+}
+
+func (r *ConfigsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_configs"
+}
+
+func (r *ConfigsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a set of configuration keys of a Git repository similar to 'git config'. Only the managed keys are removed on destroy.",
+		MarkdownDescription: "Manages a set of configuration keys of a Git repository similar to `git config`. Only the managed keys are removed on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"directory": schema.StringAttribute{
+				Description:         "The path to the local Git repository.",
+				MarkdownDescription: "The path to the local Git repository.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description:         "The combination of 'directory' and 'scope' in the form 'directory|scope'.",
+				MarkdownDescription: "The combination of `directory` and `scope` in the form `directory|scope`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scope": schema.StringAttribute{
+				Description:         "The configuration scope to write. Possible values are 'local' and 'global'. Defaults to 'local'.",
+				MarkdownDescription: "The configuration scope to write. Possible values are `local` and `global`. Defaults to `local`.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("local", "global"),
+				},
+				PlanModifiers: []planmodifier.String{
+					modifiers.DefaultString("local"),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entries": schema.MapAttribute{
+				Description:         "The configuration keys in the form 'section.name' or 'section.subsection.name' mapped to all of their values. Keys removed from this map are removed from the configuration as well.",
+				MarkdownDescription: "The configuration keys in the form `section.name` or `section.subsection.name` mapped to all of their values. Keys removed from this map are removed from the configuration as well.",
+				ElementType:         types.ListType{ElemType: types.StringType},
+				Required:            true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(configKeyPattern, "must be in the form 'section.name' or 'section.subsection.name'")),
+					mapvalidator.ValueListsAre(listvalidator.SizeAtLeast(1)),
+				},
+			},
+		},
+	}
+}
+
+func (r *ConfigsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create resource git_configs")
+
+	var inputs configsResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var entries map[string][]string
+	resp.Diagnostics.Append(inputs.Entries.ElementsAs(ctx, &entries, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repository := openRepository(ctx, inputs.Directory.ValueString(), &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	scope := inputs.Scope.ValueString()
+	changes := make([]configChange, 0, len(entries))
+	for _, key := range slices.Sorted(maps.Keys(entries)) {
+		changes = append(changes, configChange{key: key, values: entries[key]})
+	}
+	updateConfigFile(ctx, repository, scope, changes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state configsResourceModel
+	state.Directory = inputs.Directory
+	state.Id = types.StringValue(fmt.Sprintf("%s|%s", inputs.Directory.ValueString(), scope))
+	state.Scope = inputs.Scope
+	state.Entries = inputs.Entries
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ConfigsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Read resource git_configs")
+
+	var state configsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var entries map[string][]string
+	resp.Diagnostics.Append(state.Entries.ElementsAs(ctx, &entries, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repository := openRepository(ctx, state.Directory.ValueString(), &resp.Diagnostics)
+	if repository == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	raw := readRawConfig(ctx, repository, state.Scope.ValueString(), &resp.Diagnostics)
+	if raw == nil {
+		return
+	}
+
+	current := make(map[string][]string)
+	for key := range entries {
+		if values := getConfigValues(raw, key); len(values) > 0 {
+			current[key] = values
+		}
+	}
+	if len(current) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var newState configsResourceModel
+	newState.Directory = state.Directory
+	newState.Id = state.Id
+	newState.Scope = state.Scope
+	newState.Entries, diags = types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ConfigsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Update resource git_configs")
+
+	var inputs configsResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state configsResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var entries map[string][]string
+	resp.Diagnostics.Append(inputs.Entries.ElementsAs(ctx, &entries, false)...)
+	var previous map[string][]string
+	resp.Diagnostics.Append(state.Entries.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repository := openRepository(ctx, inputs.Directory.ValueString(), &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	changes := make([]configChange, 0, len(previous)+len(entries))
+	for _, key := range slices.Sorted(maps.Keys(previous)) {
+		if _, ok := entries[key]; !ok {
+			changes = append(changes, configChange{key: key})
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(entries)) {
+		changes = append(changes, configChange{key: key, values: entries[key]})
+	}
+	updateConfigFile(ctx, repository, inputs.Scope.ValueString(), changes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ConfigsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Delete resource git_configs")
+
+	var state configsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var entries map[string][]string
+	resp.Diagnostics.Append(state.Entries.ElementsAs(ctx, &entries, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repository := openRepository(ctx, state.Directory.ValueString(), &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	changes := make([]configChange, 0, len(entries))
+	for _, key := range slices.Sorted(maps.Keys(entries)) {
+		changes = append(changes, configChange{key: key})
+	}
+	updateConfigFile(ctx, repository, state.Scope.ValueString(), changes, &resp.Diagnostics)
+}
@@ -0,0 +1,202 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/metio/terraform-provider-git/internal/testutils"
+)
+
+func TestResourceGitConfigs(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_configs" "test" {
+						directory = "%s"
+						entries = {
+							"core.hooksPath"                = ["hooks"]
+							"pull.rebase"                   = ["true"]
+							"url.git@github.com:.insteadOf" = ["https://github.com/", "gh:"]
+						}
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_configs.test", "directory", directory),
+					resource.TestCheckResourceAttr("git_configs.test", "id", fmt.Sprintf("%s|local", directory)),
+					resource.TestCheckResourceAttr("git_configs.test", "scope", "local"),
+					resource.TestCheckResourceAttr("git_configs.test", "entries.%", "3"),
+					resource.TestCheckResourceAttr("git_configs.test", "entries.core.hooksPath.#", "1"),
+					resource.TestCheckResourceAttr("git_configs.test", "entries.url.git@github.com:.insteadOf.#", "2"),
+					func(_ *terraform.State) error {
+						cfg := testutils.ReadConfig(t, repository)
+						if value := cfg.Raw.Section("pull").Option("rebase"); value != "true" {
+							return fmt.Errorf("expected 'pull.rebase' to be 'true', got %q", value)
+						}
+						values := cfg.Raw.Section("url").Subsection("git@github.com:").OptionAll("insteadOf")
+						if len(values) != 2 {
+							return fmt.Errorf("unexpected values %q", values)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_configs" "test" {
+						directory = "%s"
+						entries = {
+							"core.hooksPath" = ["other"]
+						}
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_configs.test", "entries.%", "1"),
+					func(_ *terraform.State) error {
+						cfg := testutils.ReadConfig(t, repository)
+						if value := cfg.Raw.Section("core").Option("hooksPath"); value != "other" {
+							return fmt.Errorf("expected 'core.hooksPath' to be 'other', got %q", value)
+						}
+						if cfg.Raw.HasSection("pull") || cfg.Raw.HasSection("url") {
+							return fmt.Errorf("expected removed keys to be unset")
+						}
+						return nil
+					},
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			cfg := testutils.ReadConfig(t, repository)
+			if cfg.Raw.Section("core").HasOption("hooksPath") {
+				return fmt.Errorf("expected 'core.hooksPath' to be removed")
+			}
+			if !cfg.Raw.Section("core").HasOption("bare") {
+				return fmt.Errorf("expected 'core.bare' to be kept")
+			}
+			return nil
+		},
+	})
+}
+
+func TestResourceGitConfigs_Drift(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_configs" "test" {
+						directory = "%s"
+						entries = {
+							"core.hooksPath" = ["hooks"]
+							"pull.rebase"    = ["true"]
+						}
+					}
+				`, directory),
+			},
+			{
+				PreConfig: func() {
+					cfg := testutils.ReadConfig(t, repository)
+					cfg.Raw.RemoveSection("pull")
+					testutils.WriteConfig(t, repository, cfg)
+				},
+				Config: fmt.Sprintf(`
+					resource "git_configs" "test" {
+						directory = "%s"
+						entries = {
+							"core.hooksPath" = ["hooks"]
+							"pull.rebase"    = ["true"]
+						}
+					}
+				`, directory),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestResourceGitConfigs_Comments(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+	testutils.AppendConfig(t, directory, "# maintained by hand\n[pull]\n\t# only fast-forward\n\tff = only\n")
+	file := filepath.Join(directory, ".git", "config")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_configs" "test" {
+						directory = "%s"
+						entries = {
+							"core.hooksPath" = ["hooks"]
+							"pull.rebase"    = ["true"]
+						}
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(_ *terraform.State) error {
+						content, err := os.ReadFile(file)
+						if err != nil {
+							return err
+						}
+						expected := "# maintained by hand\n[pull]\n\t# only fast-forward\n\tff = only\n\trebase = true\n"
+						if !strings.Contains(string(content), expected) {
+							return fmt.Errorf("expected config to contain %q, got %q", expected, content)
+						}
+						return nil
+					},
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			content, err := os.ReadFile(file)
+			if err != nil {
+				return err
+			}
+			expected := "# maintained by hand\n[pull]\n\t# only fast-forward\n\tff = only\n"
+			if !strings.HasSuffix(string(content), expected) {
+				return fmt.Errorf("expected config to end with %q, got %q", expected, content)
+			}
+			return nil
+		},
+	})
+}
+
+func TestResourceGitConfigs_Entries_Empty(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_configs" "test" {
+						directory = "%s"
+						entries   = {}
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+			},
+		},
+	})
+}
@@ -0,0 +1,363 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/metio/terraform-provider-git/internal/modifiers"
+)
+
+type FetchResource struct {
+	defaults *GitProviderModel
+}
+
+var (
+	_ resource.Resource               = (*FetchResource)(nil)
+	_ resource.ResourceWithModifyPlan = (*FetchResource)(nil)
+	_ resource.ResourceWithConfigure  = (*FetchResource)(nil)
+)
+
+type FetchResourceModel struct {
+	Directory        types.String `tfsdk:"directory"`
+	Id               types.Int64  `tfsdk:"id"`
+	Remote           types.String `tfsdk:"remote"`
+	RefSpecs         types.List   `tfsdk:"refspecs"`
+	Depth            types.Int64  `tfsdk:"depth"`
+	Prune            types.Bool   `tfsdk:"prune"`
+	Force            types.Bool   `tfsdk:"force"`
+	Tags             types.String `tfsdk:"tags"`
+	InsecureSkipTls  types.Bool   `tfsdk:"insecure_skip_tls"`
+	CaBundleFilePath types.String `tfsdk:"ca_bundle_file_path"`
+	Auth             types.Object `tfsdk:"auth"`
+	UpdatedRefs      types.Map    `tfsdk:"updated_refs"`
+}
+
+func NewFetchResource() resource.Resource {
+	return &FetchResource{}
+}
+
+func (r *FetchResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fetch"
+}
+
+func (r *FetchResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.defaults = providerDefaults(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *FetchResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Download objects and refs from a Git remote similar to 'git fetch'",
+		MarkdownDescription: "Download objects and refs from a Git remote similar to `git fetch`",
+		Attributes: map[string]schema.Attribute{
+			"directory": schema.StringAttribute{
+				Description:         "The path to the local Git repository.",
+				MarkdownDescription: "The path to the local Git repository.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.Int64Attribute{
+				Description:         "The timestamp of the last fetch in Unix nanoseconds.",
+				MarkdownDescription: "The timestamp of the last fetch in Unix nanoseconds.",
+				Computed:            true,
+			},
+			"remote": schema.StringAttribute{
+				Description:         "The name of the remote to fetch from. Defaults to 'origin'.",
+				MarkdownDescription: "The name of the remote to fetch from. Defaults to `origin`.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					modifiers.DefaultString("origin"),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"refspecs": schema.ListAttribute{
+				Description:         "Specify what refs to fetch and which local refs to update. Note that these must be fully qualified refspecs, e.g. '+refs/heads/main:refs/remotes/origin/main'. Defaults to the fetch refspecs configured for the remote.",
+				MarkdownDescription: "Specify what refs to fetch and which local refs to update. Note that these must be fully qualified refspecs, e.g. `+refs/heads/main:refs/remotes/origin/main`. Defaults to the fetch refspecs configured for the remote.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"depth": schema.Int64Attribute{
+				Description:         "Limit fetching to the specified number of commits from the tip of each remote branch history. Defaults to '0' which fetches the entire history.",
+				MarkdownDescription: "Limit fetching to the specified number of commits from the tip of each remote branch history. Defaults to `0` which fetches the entire history.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					modifiers.DefaultInt64(0),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"prune": schema.BoolAttribute{
+				Description:         "Remove remote-tracking refs that no longer exist on the remote. Defaults to 'false'.",
+				MarkdownDescription: "Remove remote-tracking refs that no longer exist on the remote. Defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"force": schema.BoolAttribute{
+				Description:         "Allow updating a local ref even if the fetched ref is not a descendant of it. Defaults to 'false'.",
+				MarkdownDescription: "Allow updating a local ref even if the fetched ref is not a descendant of it. Defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags": schema.StringAttribute{
+				Description:         "Which tags to fetch. Use 'all' to fetch all tags, 'none' to fetch no tags, or 'following' to fetch only tags that point to fetched commits. Defaults to 'following'.",
+				MarkdownDescription: "Which tags to fetch. Use `all` to fetch all tags, `none` to fetch no tags, or `following` to fetch only tags that point to fetched commits. Defaults to `following`.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "none", "following"),
+				},
+				PlanModifiers: []planmodifier.String{
+					modifiers.DefaultString("following"),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"insecure_skip_tls": schema.BoolAttribute{
+				Description:         "Skip SSL verification if protocol is HTTPS. Defaults to the 'insecure_skip_tls' setting of the provider.",
+				MarkdownDescription: "Skip SSL verification if protocol is HTTPS. Defaults to the `insecure_skip_tls` setting of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.RequiresReplaceBoolUnlessUnset(false),
+				},
+			},
+			"ca_bundle_file_path": schema.StringAttribute{
+				Description:         "File system path to an additional CA bundle to use together with the system cert pool. Defaults to the 'ca_bundle_file_path' setting of the provider.",
+				MarkdownDescription: "File system path to an additional CA bundle to use together with the system cert pool. Defaults to the `ca_bundle_file_path` setting of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					modifiers.RequiresReplaceStringUnlessUnset(""),
+				},
+			},
+			"auth": authResourceAttribute(),
+			"updated_refs": schema.MapAttribute{
+				Description:         "The local refs that were created or updated by the last fetch mapped to their new SHA1 hash.",
+				MarkdownDescription: "The local refs that were created or updated by the last fetch mapped to their new SHA1 hash.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *FetchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create resource git_fetch")
+
+	var inputs FetchResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := inputs.Directory.ValueString()
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	options := CreateFetchOptions(ctx, &inputs, r.defaults, &resp.Diagnostics)
+	if options == nil {
+		return
+	}
+
+	before := getReferenceHashes(ctx, repository, &resp.Diagnostics)
+	if before == nil {
+		return
+	}
+
+	err := repository.FetchContext(ctx, options)
+	if !errors.Is(err, git.NoErrAlreadyUpToDate) && err != nil {
+		resp.Diagnostics.AddError(
+			"Cannot fetch from remote",
+			"Could not fetch from remote ["+options.RemoteName+"] because of: "+err.Error(),
+		)
+		return
+	}
+
+	after := getReferenceHashes(ctx, repository, &resp.Diagnostics)
+	if after == nil {
+		return
+	}
+
+	updatedRefs := make(map[string]attr.Value)
+	for name, hash := range after {
+		if previous, ok := before[name]; !ok || previous != hash {
+			updatedRefs[name.String()] = types.StringValue(hash.String())
+		}
+	}
+
+	var state FetchResourceModel
+	state.Directory = inputs.Directory
+	state.Id = types.Int64Value(time.Now().UnixNano())
+	state.Remote = inputs.Remote
+	state.RefSpecs = inputs.RefSpecs
+	state.Depth = inputs.Depth
+	state.Prune = inputs.Prune
+	state.Force = inputs.Force
+	state.Tags = inputs.Tags
+	state.InsecureSkipTls = inputs.InsecureSkipTls
+	state.CaBundleFilePath = inputs.CaBundleFilePath
+	state.Auth = inputs.Auth
+	state.UpdatedRefs, diags = types.MapValue(types.StringType, updatedRefs)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *FetchResource) Read(ctx context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+	tflog.Debug(ctx, "Read resource git_fetch")
+	// NO-OP: All data is already in Terraform state
+}
+
+func (r *FetchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Update resource git_fetch")
+
+	var inputs FetchResourceModel
+	var state FetchResourceModel
+
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// all other attributes require replacement, thus only unsetting the TLS options ends up here
+	state.InsecureSkipTls = inputs.InsecureSkipTls
+	state.CaBundleFilePath = inputs.CaBundleFilePath
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *FetchResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Delete resource git_fetch")
+	// NO-OP: Terraform removes the state automatically for us
+}
+
+func (r *FetchResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	tflog.Debug(ctx, "ModifyPlan resource git_fetch")
+
+	if req.State.Raw.IsNull() {
+		// if we're creating the resource, no need to modify it
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		// if we're deleting the resource, no need to modify it
+		return
+	}
+
+	var inputs FetchResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := inputs.Directory.ValueString()
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	remote := getRemote(ctx, repository, inputs.Remote.ValueString(), &resp.Diagnostics)
+	if remote == nil {
+		return
+	}
+
+	options := CreateFetchOptions(ctx, &inputs, r.defaults, &resp.Diagnostics)
+	if options == nil {
+		return
+	}
+
+	refSpecs := options.RefSpecs
+	if len(refSpecs) == 0 {
+		refSpecs = remote.Config().Fetch
+	}
+
+	refs, err := remote.ListContext(ctx, &git.ListOptions{
+		Auth:            options.Auth,
+		InsecureSkipTLS: options.InsecureSkipTLS,
+		CABundle:        options.CABundle,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Cannot list remote",
+			"Could not list remote ["+options.RemoteName+"] of git repository ["+directory+"] because of: "+err.Error(),
+		)
+		return
+	}
+
+	for _, ref := range refs {
+		if ref.Type() != plumbing.HashReference {
+			continue
+		}
+		for _, refSpec := range refSpecs {
+			if !refSpec.Match(ref.Name()) {
+				continue
+			}
+			local, errRef := repository.Reference(refSpec.Dst(ref.Name()), true)
+			if errRef != nil || local.Hash() != ref.Hash() {
+				tflog.Trace(ctx, "remote ref moved", map[string]interface{}{
+					"ref":  ref.Name().String(),
+					"hash": ref.Hash().String(),
+				})
+				id := path.Root("id")
+				resp.Plan.SetAttribute(ctx, id, time.Now().UnixNano())
+				resp.RequiresReplace = append(resp.RequiresReplace, id)
+				return
+			}
+		}
+	}
+}
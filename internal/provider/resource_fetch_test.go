@@ -0,0 +1,185 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/metio/terraform-provider-git/internal/testutils"
+)
+
+func TestResourceGitFetch(t *testing.T) {
+	t.Parallel()
+	upstreamDirectory, upstreamRepository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, upstreamRepository)
+	upstreamWorktree := testutils.GetRepositoryWorktree(t, upstreamRepository)
+	testutils.AddAndCommitNewFile(t, upstreamWorktree, "some-file")
+	upstreamHead := testutils.GetRepositoryHead(t, upstreamRepository)
+	directory, repository := testutils.CreateRepository(t)
+	testutils.CreateRemoteWithUrls(t, repository, "origin", []string{filepath.FromSlash(upstreamDirectory)})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_fetch" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_fetch.test", "directory", directory),
+					resource.TestCheckResourceAttrWith("git_fetch.test", "id", testutils.CheckMinLength(1)),
+					resource.TestCheckResourceAttr("git_fetch.test", "remote", "origin"),
+					resource.TestCheckNoResourceAttr("git_fetch.test", "refspecs"),
+					resource.TestCheckResourceAttr("git_fetch.test", "depth", "0"),
+					resource.TestCheckResourceAttr("git_fetch.test", "prune", "false"),
+					resource.TestCheckResourceAttr("git_fetch.test", "force", "false"),
+					resource.TestCheckResourceAttr("git_fetch.test", "tags", "following"),
+					resource.TestCheckResourceAttr("git_fetch.test", "updated_refs.%", "1"),
+					resource.TestCheckResourceAttr("git_fetch.test", "updated_refs.refs/remotes/origin/master", upstreamHead.Hash().String()),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitFetch_RefSpecs(t *testing.T) {
+	t.Parallel()
+	upstreamDirectory, upstreamRepository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, upstreamRepository)
+	upstreamWorktree := testutils.GetRepositoryWorktree(t, upstreamRepository)
+	testutils.AddAndCommitNewFile(t, upstreamWorktree, "some-file")
+	upstreamHead := testutils.GetRepositoryHead(t, upstreamRepository)
+	directory, repository := testutils.CreateRepository(t)
+	testutils.CreateRemoteWithUrls(t, repository, "origin", []string{filepath.FromSlash(upstreamDirectory)})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_fetch" "test" {
+						directory = "%s"
+						refspecs  = ["%s"]
+					}
+				`, directory, "+refs/heads/master:refs/remotes/upstream/master"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_fetch.test", "refspecs.#", "1"),
+					resource.TestCheckResourceAttr("git_fetch.test", "refspecs.0", "+refs/heads/master:refs/remotes/upstream/master"),
+					resource.TestCheckResourceAttr("git_fetch.test", "updated_refs.%", "1"),
+					resource.TestCheckResourceAttr("git_fetch.test", "updated_refs.refs/remotes/upstream/master", upstreamHead.Hash().String()),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitFetch_UpstreamMoved(t *testing.T) {
+	t.Parallel()
+	upstreamDirectory, upstreamRepository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, upstreamRepository)
+	upstreamWorktree := testutils.GetRepositoryWorktree(t, upstreamRepository)
+	testutils.AddAndCommitNewFile(t, upstreamWorktree, "some-file")
+	directory, repository := testutils.CreateRepository(t)
+	testutils.CreateRemoteWithUrls(t, repository, "origin", []string{filepath.FromSlash(upstreamDirectory)})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_fetch" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_fetch.test", "updated_refs.%", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testutils.AddAndCommitNewFile(t, upstreamWorktree, "other-file")
+				},
+				Config: fmt.Sprintf(`
+					resource "git_fetch" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("git_fetch.test", "updated_refs.refs/remotes/origin/master", func(value string) error {
+						head := testutils.GetRepositoryHead(t, upstreamRepository)
+						if value != head.Hash().String() {
+							return fmt.Errorf("expected %s but got %s", head.Hash().String(), value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitFetch_Remote_Unknown(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_fetch" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`remote not found`),
+			},
+		},
+	})
+}
+
+func TestResourceGitFetch_Directory_Invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "git_fetch" "test" {
+						directory = "/does/not/exist"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Cannot open repository`),
+			},
+		},
+	})
+}
+
+func TestResourceGitFetch_Tags_Invalid(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_fetch" "test" {
+						directory = "%s"
+						tags      = "some"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
@@ -0,0 +1,437 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/metio/terraform-provider-git/internal/modifiers"
+)
+
+type FileResource struct{}
+
+var (
+	_ resource.Resource                = (*FileResource)(nil)
+	_ resource.ResourceWithImportState = (*FileResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*FileResource)(nil)
+)
+
+type fileResourceModel struct {
+	Directory     types.String `tfsdk:"directory"`
+	Id            types.String `tfsdk:"id"`
+	Path          types.String `tfsdk:"path"`
+	Content       types.String `tfsdk:"content"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	Mode          types.String `tfsdk:"mode"`
+	SHA1          types.String `tfsdk:"sha1"`
+}
+
+func NewFileResource() resource.Resource {
+	return &FileResource{}
+}
+
+func (r *FileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file"
+}
+
+func (r *FileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manage a file in the worktree of a Git repository and stage it similar to 'git add'.",
+		MarkdownDescription: "Manage a file in the worktree of a Git repository and stage it similar to `git add`.",
+		Attributes: map[string]schema.Attribute{
+			"directory": schema.StringAttribute{
+				Description:         "The path to the local Git repository.",
+				MarkdownDescription: "The path to the local Git repository.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description:         "The import ID to import this resource which has the form 'directory|path'",
+				MarkdownDescription: "The import ID to import this resource which has the form `'directory|path'`",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				Description:         "The path of the file relative to the root of the worktree. Parent directories are created as needed.",
+				MarkdownDescription: "The path of the file relative to the root of the worktree. Parent directories are created as needed.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description:         "The UTF-8 encoded content of the file. Conflicts with 'content_base64'.",
+				MarkdownDescription: "The UTF-8 encoded content of the file. Conflicts with `content_base64`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("content_base64")),
+				},
+			},
+			"content_base64": schema.StringAttribute{
+				Description:         "The base64 encoded content of the file. Use this for binary files. Conflicts with 'content'.",
+				MarkdownDescription: "The base64 encoded content of the file. Use this for binary files. Conflicts with `content`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("content")),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Description:         "The permissions of the file as an octal number. Git itself only tracks whether a file is executable. Defaults to '0644'.",
+				MarkdownDescription: "The permissions of the file as an octal number. Git itself only tracks whether a file is executable. Defaults to `0644`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[0-7]{4}$`), "must be a four digit octal number, e.g. '0644'"),
+				},
+				PlanModifiers: []planmodifier.String{
+					modifiers.DefaultString("0644"),
+				},
+			},
+			"sha1": schema.StringAttribute{
+				Description:         "The SHA1 hash of the blob of the file in the index.",
+				MarkdownDescription: "The SHA1 hash of the blob of the file in the index.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create resource git_file")
+
+	var inputs fileResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := inputs.Directory.ValueString()
+	filePath := inputs.Path.ValueString()
+
+	if !isWorktreePath(filePath, &resp.Diagnostics) {
+		return
+	}
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	worktree := fileWorktree(repository, directory, &resp.Diagnostics)
+	if worktree == nil {
+		return
+	}
+
+	content := fileContent(inputs.Content, inputs.ContentBase64, &resp.Diagnostics)
+	mode := fileMode(inputs.Mode, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	writeWorktreeFile(ctx, worktree, filePath, content, mode, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state fileResourceModel
+	state.Directory = inputs.Directory
+	state.Id = types.StringValue(fmt.Sprintf("%s|%s", directory, filePath))
+	state.Path = inputs.Path
+	state.Content = inputs.Content
+	state.ContentBase64 = inputs.ContentBase64
+	state.Mode = inputs.Mode
+	state.SHA1 = types.StringValue(blobHash(content).String())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *FileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Read resource git_file")
+
+	var state fileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repository := openRepository(ctx, state.Directory.ValueString(), &resp.Diagnostics)
+	if repository == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	exists := readWorktreeFile(ctx, repository, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !exists {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *FileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Update resource git_file")
+
+	var inputs fileResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := inputs.Directory.ValueString()
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	worktree := fileWorktree(repository, directory, &resp.Diagnostics)
+	if worktree == nil {
+		return
+	}
+
+	content := fileContent(inputs.Content, inputs.ContentBase64, &resp.Diagnostics)
+	mode := fileMode(inputs.Mode, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	writeWorktreeFile(ctx, worktree, inputs.Path.ValueString(), content, mode, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputs.SHA1 = types.StringValue(blobHash(content).String())
+
+	diags = resp.State.Set(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *FileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Delete resource git_file")
+
+	var state fileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := state.Directory.ValueString()
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	worktree := fileWorktree(repository, directory, &resp.Diagnostics)
+	if worktree == nil {
+		return
+	}
+
+	removeWorktreeFile(ctx, worktree, state.Path.ValueString(), &resp.Diagnostics)
+}
+
+func (r *FileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "ImportState resource git_file")
+
+	id := req.ID
+	idParts := strings.Split(id, "|")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: 'path/to/your/git/repository|path/to/file' Got: %q", id),
+		)
+		return
+	}
+
+	directory := idParts[0]
+	filePath := idParts[1]
+
+	if !isWorktreePath(filePath, &resp.Diagnostics) {
+		return
+	}
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	var state fileResourceModel
+	state.Directory = types.StringValue(directory)
+	state.Id = types.StringValue(id)
+	state.Path = types.StringValue(filePath)
+	state.Content = types.StringValue("")
+	state.ContentBase64 = types.StringNull()
+
+	exists := readWorktreeFile(ctx, repository, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !exists {
+		resp.Diagnostics.AddError(
+			"Cannot read file",
+			"The file ["+filePath+"] does not exist in ["+directory+"]",
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *FileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	tflog.Debug(ctx, "ModifyPlan resource git_file")
+
+	if req.State.Raw.IsNull() {
+		// if we're creating the resource, no need to modify it
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		// if we're deleting the resource, no need to modify it
+		return
+	}
+
+	var inputs fileResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sha1 := path.Root("sha1")
+
+	if inputs.Content.IsUnknown() || inputs.ContentBase64.IsUnknown() {
+		resp.Plan.SetAttribute(ctx, sha1, types.StringUnknown())
+		return
+	}
+
+	content := fileContent(inputs.Content, inputs.ContentBase64, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// a different hash means that either the content changed or the index no longer matches the worktree
+	hash := blobHash(content).String()
+	if inputs.SHA1.ValueString() != hash {
+		resp.Plan.SetAttribute(ctx, sha1, hash)
+	}
+}
+
+func fileWorktree(repository *git.Repository, directory string, diag *diag.Diagnostics) *git.Worktree {
+	worktree, err := getWorktree(repository, diag)
+	if err != nil {
+		return nil
+	}
+	if worktree == nil {
+		diag.AddError(
+			"Cannot manage file in bare repository",
+			"The repository at ["+directory+"] is bare. Create a worktree first in order to manage files.",
+		)
+		return nil
+	}
+	return worktree
+}
+
+// readWorktreeFile refreshes the content, mode, and hash of the given state with the file in the worktree. Content
+// is written to 'content_base64' if the state uses it, otherwise to 'content'. Returns false if the file does not exist.
+func readWorktreeFile(ctx context.Context, repository *git.Repository, state *fileResourceModel, diag *diag.Diagnostics) bool {
+	worktree := fileWorktree(repository, state.Directory.ValueString(), diag)
+	if worktree == nil {
+		return false
+	}
+
+	filePath := state.Path.ValueString()
+	filename := filepath.Join(worktree.Filesystem.Root(), filePath)
+
+	info, err := os.Stat(filename)
+	if os.IsNotExist(err) {
+		return false
+	} else if err != nil {
+		diag.AddError(
+			"Cannot read file",
+			"Could not read file ["+filePath+"] because of: "+err.Error(),
+		)
+		return false
+	}
+
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		diag.AddError(
+			"Cannot read file",
+			"Could not read file ["+filePath+"] because of: "+err.Error(),
+		)
+		return false
+	}
+
+	if !state.ContentBase64.IsNull() {
+		state.ContentBase64 = types.StringValue(base64.StdEncoding.EncodeToString(content))
+	} else {
+		state.Content = types.StringValue(string(content))
+	}
+	state.Mode = types.StringValue(fmt.Sprintf("%04o", info.Mode().Perm()))
+
+	indexHash := getIndexHash(ctx, repository, filePath, diag)
+	if diag.HasError() {
+		return false
+	}
+	if indexHash == nil {
+		state.SHA1 = types.StringNull()
+	} else {
+		state.SHA1 = types.StringValue(indexHash.String())
+	}
+
+	return true
+}
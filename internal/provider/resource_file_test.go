@@ -0,0 +1,268 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/metio/terraform-provider-git/internal/testutils"
+)
+
+func TestResourceGitFile(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	hash := plumbing.ComputeHash(plumbing.BlobObject, []byte("hello world"))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_file" "test" {
+						directory = "%s"
+						path      = "some/nested/file.txt"
+						content   = "hello world"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_file.test", "directory", directory),
+					resource.TestCheckResourceAttr("git_file.test", "id", fmt.Sprintf("%s|%s", directory, "some/nested/file.txt")),
+					resource.TestCheckResourceAttr("git_file.test", "path", "some/nested/file.txt"),
+					resource.TestCheckResourceAttr("git_file.test", "content", "hello world"),
+					resource.TestCheckNoResourceAttr("git_file.test", "content_base64"),
+					resource.TestCheckResourceAttr("git_file.test", "mode", "0644"),
+					resource.TestCheckResourceAttr("git_file.test", "sha1", hash.String()),
+					testutils.CheckFileContent(worktree, "some/nested/file.txt", "hello world"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitFile_Base64(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	hash := plumbing.ComputeHash(plumbing.BlobObject, []byte("hello world"))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_file" "test" {
+						directory      = "%s"
+						path           = "file.bin"
+						content_base64 = "aGVsbG8gd29ybGQ="
+						mode           = "0755"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("git_file.test", "content"),
+					resource.TestCheckResourceAttr("git_file.test", "content_base64", "aGVsbG8gd29ybGQ="),
+					resource.TestCheckResourceAttr("git_file.test", "mode", "0755"),
+					resource.TestCheckResourceAttr("git_file.test", "sha1", hash.String()),
+					testutils.CheckFileContent(worktree, "file.bin", "hello world"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitFile_Update(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_file" "test" {
+						directory = "%s"
+						path      = "file.txt"
+						content   = "first"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_file.test", "sha1", plumbing.ComputeHash(plumbing.BlobObject, []byte("first")).String()),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_file" "test" {
+						directory = "%s"
+						path      = "file.txt"
+						content   = "second"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_file.test", "content", "second"),
+					resource.TestCheckResourceAttr("git_file.test", "sha1", plumbing.ComputeHash(plumbing.BlobObject, []byte("second")).String()),
+					testutils.CheckFileContent(worktree, "file.txt", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitFile_Drift(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	hash := plumbing.ComputeHash(plumbing.BlobObject, []byte("expected"))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_file" "test" {
+						directory = "%s"
+						path      = "file.txt"
+						content   = "expected"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_file.test", "sha1", hash.String()),
+				),
+			},
+			{
+				PreConfig: func() {
+					testutils.WriteFileContent(t, testutils.FileInWorktree(worktree, "file.txt"), "manual edit")
+					testutils.GitAdd(t, worktree, "file.txt")
+				},
+				Config: fmt.Sprintf(`
+					resource "git_file" "test" {
+						directory = "%s"
+						path      = "file.txt"
+						content   = "expected"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_file.test", "content", "expected"),
+					resource.TestCheckResourceAttr("git_file.test", "sha1", hash.String()),
+					testutils.CheckFileContent(worktree, "file.txt", "expected"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitFile_Import(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.WriteFileContent(t, testutils.FileInWorktree(worktree, "file.txt"), "existing")
+	testutils.GitAdd(t, worktree, "file.txt")
+	hash := plumbing.ComputeHash(plumbing.BlobObject, []byte("existing"))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_file" "test" {
+						directory = "%s"
+						path      = "file.txt"
+						content   = "existing"
+					}
+				`, directory),
+				ResourceName:       "git_file.test",
+				ImportState:        true,
+				ImportStateId:      fmt.Sprintf("%s|%s", directory, "file.txt"),
+				ImportStatePersist: true,
+				ImportStateCheck: testutils.ComposeImportStateCheck(
+					testutils.CheckResourceAttrInstanceState("directory", directory),
+					testutils.CheckResourceAttrInstanceState("id", fmt.Sprintf("%s|%s", directory, "file.txt")),
+					testutils.CheckResourceAttrInstanceState("path", "file.txt"),
+					testutils.CheckResourceAttrInstanceState("content", "existing"),
+					testutils.CheckResourceAttrInstanceState("sha1", hash.String()),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitFile_Import_InvalidPath(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+	outside := filepath.Join(filepath.Dir(directory), "outside.txt")
+	testutils.WriteFileContent(t, outside, "outside")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_file" "test" {
+						directory = "%s"
+						path      = "outside.txt"
+						content   = "outside"
+					}
+				`, directory),
+				ResourceName:       "git_file.test",
+				ImportState:        true,
+				ImportStateId:      fmt.Sprintf("%s|%s", directory, "../outside.txt"),
+				ImportStatePersist: false,
+				ExpectError:        regexp.MustCompile(`Invalid file path`),
+			},
+		},
+	})
+	if _, err := os.Stat(outside); err != nil {
+		t.Errorf("expected [%s] to be kept: %v", outside, err)
+	}
+}
+
+func TestResourceGitFile_InvalidPath(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_file" "test" {
+						directory = "%s"
+						path      = "../outside.txt"
+						content   = "content"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Invalid file path`),
+			},
+		},
+	})
+}
+
+func TestResourceGitFile_BareRepository(t *testing.T) {
+	t.Parallel()
+	directory := testutils.CreateBareRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_file" "test" {
+						directory = "%s"
+						path      = "file.txt"
+						content   = "content"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot manage file in bare repository`),
+			},
+		},
+	})
+}
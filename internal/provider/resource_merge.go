@@ -0,0 +1,415 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/metio/terraform-provider-git/internal/modifiers"
+)
+
+type MergeResource struct {
+	defaults *GitProviderModel
+}
+
+var (
+	_ resource.Resource               = (*MergeResource)(nil)
+	_ resource.ResourceWithModifyPlan = (*MergeResource)(nil)
+	_ resource.ResourceWithConfigure  = (*MergeResource)(nil)
+)
+
+type mergeResourceModel struct {
+	Directory types.String `tfsdk:"directory"`
+	Id        types.Int64  `tfsdk:"id"`
+	Revision  types.String `tfsdk:"revision"`
+	Strategy  types.String `tfsdk:"strategy"`
+	Message   types.String `tfsdk:"message"`
+	Author    types.Object `tfsdk:"author"`
+	Committer types.Object `tfsdk:"committer"`
+	Signing   types.Object `tfsdk:"signing"`
+	SHA1      types.String `tfsdk:"sha1"`
+	Conflicts types.List   `tfsdk:"conflicts"`
+}
+
+func NewMergeResource() resource.Resource {
+	return &MergeResource{}
+}
+
+func (r *MergeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_merge"
+}
+
+func (r *MergeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.defaults = providerDefaults(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *MergeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	signing := signingResourceAttribute()
+	signing.Description = "The private key used to sign the created merge commit. If none is specified, the merge commit will not be signed."
+	signing.MarkdownDescription = "The private key used to sign the created merge commit. If none is specified, the merge commit will not be signed."
+
+	resp.Schema = schema.Schema{
+		Description: "Join two development histories together similar to 'git merge'. Files changed on both sides are reported as conflicts and leave the worktree untouched. Note that configuration changes to this resource which cause a replacement will merge again and keep the previous merge as-is.",
+		MarkdownDescription: "Join two development histories together similar to `git merge`. Files changed on both sides are reported as conflicts and leave the worktree untouched.\n\n" +
+			"-> **Note** Configuration changes to this resource which cause a replacement will merge again and keep the previous merge as-is.",
+		Attributes: map[string]schema.Attribute{
+			"directory": schema.StringAttribute{
+				Description:         "The path to the local Git repository.",
+				MarkdownDescription: "The path to the local Git repository.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.Int64Attribute{
+				Description:         "The timestamp of the last merge in Unix nanoseconds.",
+				MarkdownDescription: "The timestamp of the last merge in Unix nanoseconds.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"revision": schema.StringAttribute{
+				Description:         "The revision to merge into the currently checked out branch. Note that 'go-git' does not support every revision type at the moment.",
+				MarkdownDescription: "The [revision](https://www.git-scm.com/docs/gitrevisions) to merge into the currently checked out branch. Note that `go-git` does not [support](https://pkg.go.dev/github.com/go-git/go-git/v5#Repository.ResolveRevision) every revision type at the moment.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"strategy": schema.StringAttribute{
+				Description:         "How to merge the revision. Possible values are 'three-way' to fast-forward if possible and create a merge commit otherwise, 'fast-forward-only' to fail unless the branch can be fast-forwarded, and 'no-ff' to always create a merge commit. Defaults to 'three-way'.",
+				MarkdownDescription: "How to merge the revision. Possible values are `three-way` to fast-forward if possible and create a merge commit otherwise, `fast-forward-only` to fail unless the branch can be fast-forwarded, and `no-ff` to always create a merge commit. Defaults to `three-way`.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("three-way", "fast-forward-only", "no-ff"),
+				},
+				PlanModifiers: []planmodifier.String{
+					modifiers.DefaultString("three-way"),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"message": schema.StringAttribute{
+				Description:         "The message of the merge commit. Defaults to 'Merge <revision>'.",
+				MarkdownDescription: "The message of the merge commit. Defaults to `Merge <revision>`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"author":    authorResourceAttribute(),
+			"committer": committerResourceAttribute(),
+			"signing":   signing,
+			"sha1": schema.StringAttribute{
+				Description:         "The SHA1 hash of 'HEAD' after the merge.",
+				MarkdownDescription: "The SHA1 hash of `HEAD` after the merge.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"conflicts": schema.ListAttribute{
+				Description:         "The files changed on both sides and the changed submodules which prevented the merge. Submodules cannot be checked out by the merge, thus they have to be merged by hand. Empty after a successful merge.",
+				MarkdownDescription: "The files changed on both sides and the changed submodules which prevented the merge. Submodules cannot be checked out by the merge, thus they have to be merged by hand. Empty after a successful merge.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *MergeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create resource git_merge")
+
+	var inputs mergeResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := inputs.Directory.ValueString()
+	revision := inputs.Revision.ValueString()
+	strategy := inputs.Strategy.ValueString()
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	worktree, err := getWorktree(repository, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+	if worktree == nil {
+		resp.Diagnostics.AddError(
+			"Cannot merge in bare repository",
+			"The repository at ["+directory+"] is bare. Create a worktree first in order to merge changes.",
+		)
+		return
+	}
+
+	status := getStatus(ctx, worktree, &resp.Diagnostics)
+	if status == nil {
+		return
+	}
+	if hasLocalChanges(status) {
+		resp.Diagnostics.AddError(
+			"Cannot merge with local changes",
+			"The index or the worktree of repository ["+directory+"] contain uncommitted changes. Commit or reset them first in order to merge changes.",
+		)
+		return
+	}
+
+	head, err := repository.Head()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Cannot read HEAD",
+			"Could not read HEAD of repository ["+directory+"] because of: "+err.Error(),
+		)
+		return
+	}
+	headHash := head.Hash()
+	ours := getCommit(ctx, repository, &headHash, &resp.Diagnostics)
+	if ours == nil {
+		return
+	}
+
+	theirHash := resolveRevision(ctx, repository, revision, &resp.Diagnostics)
+	if theirHash == nil {
+		return
+	}
+	theirs := getCommit(ctx, repository, theirHash, &resp.Diagnostics)
+	if theirs == nil {
+		return
+	}
+
+	var state mergeResourceModel
+	state.Directory = inputs.Directory
+	state.Id = types.Int64Value(time.Now().UnixNano())
+	state.Revision = inputs.Revision
+	state.Strategy = inputs.Strategy
+	state.Message = inputs.Message
+	state.Author = signatureObject(&inputs.Author)
+	state.Committer = signatureObject(&inputs.Committer)
+	state.Signing = inputs.Signing
+	state.SHA1 = types.StringValue(ours.Hash.String())
+	state.Conflicts, _ = types.ListValueFrom(ctx, types.StringType, []string{})
+
+	upToDate := isAncestor(ctx, theirs, ours, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	fastForward := !upToDate && isAncestor(ctx, ours, theirs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case upToDate:
+		tflog.Trace(ctx, "already up to date", map[string]interface{}{
+			"directory": directory,
+			"revision":  revision,
+		})
+	case fastForward && strategy != "no-ff":
+		merged, conflicts := threeWayMerge(ctx, worktree, ours, ours, theirs, &resp.Diagnostics)
+		if len(conflicts) > 0 {
+			reportMergeConflicts(ctx, &state, conflicts, directory, revision, resp)
+			return
+		}
+		if !merged {
+			return
+		}
+		err = repository.Storer.SetReference(plumbing.NewHashReference(head.Name(), theirs.Hash))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Cannot fast-forward",
+				"Could not update ["+head.Name().String()+"] of repository ["+directory+"] because of: "+err.Error(),
+			)
+			return
+		}
+		state.SHA1 = types.StringValue(theirs.Hash.String())
+	case strategy == "fast-forward-only":
+		resp.Diagnostics.AddError(
+			"Cannot fast-forward",
+			"Could not merge ["+revision+"] into repository ["+directory+"] because ["+head.Name().Short()+"] has diverged and cannot be fast-forwarded. "+
+				"Set 'strategy' to 'three-way' in order to create a merge commit.",
+		)
+		return
+	default:
+		base := mergeBase(ctx, ours, theirs, &resp.Diagnostics)
+		if base == nil {
+			return
+		}
+
+		merged, conflicts := threeWayMerge(ctx, worktree, base, ours, theirs, &resp.Diagnostics)
+		if len(conflicts) > 0 {
+			reportMergeConflicts(ctx, &state, conflicts, directory, revision, resp)
+			return
+		}
+		if !merged {
+			return
+		}
+
+		hash := createMergeCommit(ctx, inputs, ours, theirs, r.defaults, worktree, &resp.Diagnostics)
+		if hash == nil {
+			return
+		}
+
+		commitObject := getCommit(ctx, repository, hash, &resp.Diagnostics)
+		if commitObject == nil {
+			return
+		}
+
+		state.Author = signatureToObjectWithoutTimestamp(&commitObject.Author)
+		state.Committer = signatureToObjectWithoutTimestamp(&commitObject.Committer)
+		state.SHA1 = types.StringValue(hash.String())
+	}
+
+	tflog.Trace(ctx, "merged revision", map[string]interface{}{
+		"directory": directory,
+		"revision":  revision,
+		"head":      state.SHA1.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// reportMergeConflicts stores the state along with the error, thus the conflicts are visible in the tainted resource.
+func reportMergeConflicts(ctx context.Context, state *mergeResourceModel, conflicts []string, directory string, revision string, resp *resource.CreateResponse) {
+	state.Conflicts, _ = types.ListValueFrom(ctx, types.StringType, conflicts)
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.AddError(
+		"Cannot merge conflicting changes",
+		"Could not merge ["+revision+"] into repository ["+directory+"] because the following files were changed on both sides or are submodules: "+strings.Join(conflicts, ", "),
+	)
+}
+
+func (r *MergeResource) Read(ctx context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+	tflog.Debug(ctx, "Read resource git_merge")
+	// NO-OP: All data is already in Terraform state
+}
+
+func (r *MergeResource) Update(ctx context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Update resource git_merge")
+	// NO-OP: All attributes require replacement, thus delete/create will be called
+}
+
+func (r *MergeResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Delete resource git_merge")
+	// NO-OP: Terraform removes the state automatically for us
+}
+
+func (r *MergeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	tflog.Debug(ctx, "ModifyPlan resource git_merge")
+
+	if req.State.Raw.IsNull() {
+		// if we're creating the resource, no need to modify it
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		// if we're deleting the resource, no need to modify it
+		return
+	}
+
+	var inputs mergeResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := inputs.Directory.ValueString()
+	revision := inputs.Revision.ValueString()
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	head, err := repository.Head()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Cannot read HEAD",
+			"Could not read HEAD of repository ["+directory+"] because of: "+err.Error(),
+		)
+		return
+	}
+	headHash := head.Hash()
+	ours := getCommit(ctx, repository, &headHash, &resp.Diagnostics)
+	if ours == nil {
+		return
+	}
+
+	theirHash := resolveRevision(ctx, repository, revision, &resp.Diagnostics)
+	if theirHash == nil {
+		return
+	}
+	theirs := getCommit(ctx, repository, theirHash, &resp.Diagnostics)
+	if theirs == nil {
+		return
+	}
+
+	if isAncestor(ctx, theirs, ours, &resp.Diagnostics) || resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the revision contains commits which are not merged yet, thus we have to merge again
+	id := path.Root("id")
+	resp.Plan.SetAttribute(ctx, id, time.Now().UnixNano())
+	resp.RequiresReplace = append(resp.RequiresReplace, id)
+
+	base := mergeBase(ctx, ours, theirs, &resp.Diagnostics)
+	if base == nil {
+		return
+	}
+	baseTree := getTree(ctx, base, &resp.Diagnostics)
+	ourTree := getTree(ctx, ours, &resp.Diagnostics)
+	theirTree := getTree(ctx, theirs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	merge := mergeTrees(ctx, baseTree, ourTree, theirTree, &resp.Diagnostics)
+	if merge != nil && len(merge.conflicts) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Merge conflicts",
+			"Merging ["+revision+"] into repository ["+directory+"] will fail because the following files were changed on both sides: "+strings.Join(merge.conflicts, ", "),
+		)
+	}
+}
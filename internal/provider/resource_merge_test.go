@@ -0,0 +1,447 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/metio/terraform-provider-git/internal/testutils"
+)
+
+func TestResourceGitMerge_FastForward(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.GitCheckoutBranch(t, worktree, "feature", true)
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	feature := testutils.GetRepositoryHead(t, repository)
+	testutils.GitCheckoutBranch(t, worktree, "master", false)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_merge" "test" {
+						directory = "%s"
+						revision  = "feature"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_merge.test", "directory", directory),
+					resource.TestCheckResourceAttrWith("git_merge.test", "id", testutils.CheckMinLength(1)),
+					resource.TestCheckResourceAttr("git_merge.test", "revision", "feature"),
+					resource.TestCheckResourceAttr("git_merge.test", "strategy", "three-way"),
+					resource.TestCheckNoResourceAttr("git_merge.test", "message"),
+					resource.TestCheckResourceAttr("git_merge.test", "sha1", feature.Hash().String()),
+					resource.TestCheckResourceAttr("git_merge.test", "conflicts.#", "0"),
+					testutils.CheckFileContent(worktree, "other-file", "hello world!"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitMerge_FastForwardOnly(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.GitCheckoutBranch(t, worktree, "feature", true)
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	feature := testutils.GetRepositoryHead(t, repository)
+	testutils.GitCheckoutBranch(t, worktree, "master", false)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_merge" "test" {
+						directory = "%s"
+						revision  = "feature"
+						strategy  = "fast-forward-only"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_merge.test", "strategy", "fast-forward-only"),
+					resource.TestCheckResourceAttr("git_merge.test", "sha1", feature.Hash().String()),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitMerge_FastForwardOnly_Diverged(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.GitCheckoutBranch(t, worktree, "feature", true)
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	testutils.GitCheckoutBranch(t, worktree, "master", false)
+	testutils.AddAndCommitNewFile(t, worktree, "master-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_merge" "test" {
+						directory = "%s"
+						revision  = "feature"
+						strategy  = "fast-forward-only"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot fast-forward`),
+			},
+		},
+	})
+}
+
+func TestResourceGitMerge_NoFastForward(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	cfg := testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.GitCheckoutBranch(t, worktree, "feature", true)
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	feature := testutils.GetRepositoryHead(t, repository)
+	testutils.GitCheckoutBranch(t, worktree, "master", false)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_merge" "test" {
+						directory = "%s"
+						revision  = "feature"
+						strategy  = "no-ff"
+						message   = "merge feature branch"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_merge.test", "strategy", "no-ff"),
+					resource.TestCheckResourceAttr("git_merge.test", "message", "merge feature branch"),
+					resource.TestCheckResourceAttr("git_merge.test", "author.name", cfg.Author.Name),
+					resource.TestCheckResourceAttr("git_merge.test", "author.email", cfg.Author.Email),
+					resource.TestCheckResourceAttr("git_merge.test", "committer.name", cfg.Committer.Name),
+					resource.TestCheckResourceAttr("git_merge.test", "committer.email", cfg.Committer.Email),
+					resource.TestCheckResourceAttrWith("git_merge.test", "sha1", testutils.CheckExactLength(40)),
+					resource.TestCheckResourceAttrWith("git_merge.test", "sha1", func(value string) error {
+						commit, err := repository.CommitObject(plumbing.NewHash(value))
+						if err != nil {
+							return err
+						}
+						if commit.Message != "merge feature branch" {
+							return fmt.Errorf("unexpected merge commit message %q", commit.Message)
+						}
+						if commit.NumParents() != 2 || commit.ParentHashes[1] != feature.Hash() {
+							return fmt.Errorf("expected merge commit to have parent %s", feature.Hash())
+						}
+						return nil
+					}),
+					testutils.CheckFileContent(worktree, "other-file", "hello world!"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitMerge_ThreeWay(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.GitCheckoutBranch(t, worktree, "feature", true)
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	feature := testutils.GetRepositoryHead(t, repository)
+	testutils.GitCheckoutBranch(t, worktree, "master", false)
+	testutils.AddAndCommitNewFile(t, worktree, "master-file")
+	master := testutils.GetRepositoryHead(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_merge" "test" {
+						directory = "%s"
+						revision  = "feature"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("git_merge.test", "sha1", testutils.CheckExactLength(40)),
+					resource.TestCheckResourceAttr("git_merge.test", "conflicts.#", "0"),
+					resource.TestCheckResourceAttrWith("git_merge.test", "sha1", func(value string) error {
+						commit, err := repository.CommitObject(plumbing.NewHash(value))
+						if err != nil {
+							return err
+						}
+						if commit.Message != "Merge feature" {
+							return fmt.Errorf("unexpected merge commit message %q", commit.Message)
+						}
+						if commit.NumParents() != 2 || commit.ParentHashes[0] != master.Hash() || commit.ParentHashes[1] != feature.Hash() {
+							return fmt.Errorf("expected merge commit to have parents %s and %s", master.Hash(), feature.Hash())
+						}
+						return nil
+					}),
+					testutils.CheckFileContent(worktree, "other-file", "hello world!"),
+					testutils.CheckFileContent(worktree, "master-file", "hello world!"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitMerge_Conflicts(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	name := "some-file"
+	testutils.AddAndCommitNewFile(t, worktree, name)
+	testutils.GitCheckoutBranch(t, worktree, "feature", true)
+	testutils.WriteFileContent(t, testutils.FileInWorktree(worktree, name), "feature")
+	testutils.GitAdd(t, worktree, name)
+	testutils.GitCommit(t, worktree)
+	testutils.GitCheckoutBranch(t, worktree, "master", false)
+	testutils.WriteFileContent(t, testutils.FileInWorktree(worktree, name), "master")
+	testutils.GitAdd(t, worktree, name)
+	testutils.GitCommit(t, worktree)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_merge" "test" {
+						directory = "%s"
+						revision  = "feature"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot merge conflicting changes`),
+			},
+		},
+	})
+	if err := testutils.CheckFileContent(worktree, name, "master")(nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestResourceGitMerge_FastForward_Submodule(t *testing.T) {
+	t.Parallel()
+	submoduleDirectory, submoduleRepository := testutils.CreateRepository(t)
+	submoduleWorktree := testutils.GetRepositoryWorktree(t, submoduleRepository)
+	testutils.AddAndCommitNewFile(t, submoduleWorktree, "library-file")
+	submoduleHead := testutils.GetRepositoryHead(t, submoduleRepository)
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.GitCheckoutBranch(t, worktree, "feature", true)
+	testutils.AddSubmodule(t, repository, "library", "vendor/library", submoduleDirectory, submoduleHead.Hash())
+	testutils.GitCommit(t, worktree)
+	testutils.GitCheckoutBranch(t, worktree, "master", false)
+	head := testutils.GetRepositoryHead(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_merge" "test" {
+						directory = "%s"
+						revision  = "feature"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot merge conflicting changes`),
+			},
+		},
+	})
+	if current := testutils.GetRepositoryHead(t, repository); current.Hash() != head.Hash() {
+		t.Errorf("expected HEAD to stay at %s, got %s", head.Hash(), current.Hash())
+	}
+}
+
+func TestResourceGitMerge_UpToDate(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.GitCheckoutBranch(t, worktree, "feature", true)
+	testutils.GitCheckoutBranch(t, worktree, "master", false)
+	testutils.AddAndCommitNewFile(t, worktree, "master-file")
+	head := testutils.GetRepositoryHead(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_merge" "test" {
+						directory = "%s"
+						revision  = "feature"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_merge.test", "sha1", head.Hash().String()),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitMerge_NewCommits(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.GitCheckoutBranch(t, worktree, "feature", true)
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	first := testutils.GetRepositoryHead(t, repository)
+	testutils.GitCheckoutBranch(t, worktree, "master", false)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_merge" "test" {
+						directory = "%s"
+						revision  = "feature"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_merge.test", "sha1", first.Hash().String()),
+				),
+			},
+			{
+				PreConfig: func() {
+					testutils.GitCheckoutBranch(t, worktree, "feature", false)
+					testutils.AddAndCommitNewFile(t, worktree, "another-file")
+					testutils.GitCheckoutBranch(t, worktree, "master", false)
+				},
+				Config: fmt.Sprintf(`
+					resource "git_merge" "test" {
+						directory = "%s"
+						revision  = "feature"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("git_merge.test", "sha1", func(value string) error {
+						if value == first.Hash().String() {
+							return fmt.Errorf("expected new commits of 'feature' to be merged")
+						}
+						return nil
+					}),
+					testutils.CheckFileContent(worktree, "another-file", "hello world!"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitMerge_LocalChanges(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	name := "some-file"
+	testutils.AddAndCommitNewFile(t, worktree, name)
+	testutils.GitCheckoutBranch(t, worktree, "feature", true)
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	testutils.GitCheckoutBranch(t, worktree, "master", false)
+	testutils.WriteFileContent(t, testutils.FileInWorktree(worktree, name), "changed")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_merge" "test" {
+						directory = "%s"
+						revision  = "feature"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot merge with local changes`),
+			},
+		},
+	})
+}
+
+func TestResourceGitMerge_BareRepository(t *testing.T) {
+	t.Parallel()
+	directory := testutils.CreateBareRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_merge" "test" {
+						directory = "%s"
+						revision  = "feature"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot merge in bare repository`),
+			},
+		},
+	})
+}
+
+func TestResourceGitMerge_Strategy_Invalid(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_merge" "test" {
+						directory = "%s"
+						revision  = "feature"
+						strategy  = "octopus"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
+func TestResourceGitMerge_Revision_Missing(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_merge" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Missing required argument`),
+			},
+		},
+	})
+}
@@ -0,0 +1,389 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/metio/terraform-provider-git/internal/modifiers"
+)
+
+type PullResource struct {
+	defaults *GitProviderModel
+}
+
+var (
+	_ resource.Resource               = (*PullResource)(nil)
+	_ resource.ResourceWithModifyPlan = (*PullResource)(nil)
+	_ resource.ResourceWithConfigure  = (*PullResource)(nil)
+)
+
+type PullResourceModel struct {
+	Directory        types.String `tfsdk:"directory"`
+	Id               types.Int64  `tfsdk:"id"`
+	RemoteName       types.String `tfsdk:"remote_name"`
+	ReferenceName    types.String `tfsdk:"reference_name"`
+	SingleBranch     types.Bool   `tfsdk:"single_branch"`
+	Depth            types.Int64  `tfsdk:"depth"`
+	Force            types.Bool   `tfsdk:"force"`
+	InsecureSkipTls  types.Bool   `tfsdk:"insecure_skip_tls"`
+	CaBundleFilePath types.String `tfsdk:"ca_bundle_file_path"`
+	Auth             types.Object `tfsdk:"auth"`
+	PreviousSHA1     types.String `tfsdk:"previous_sha1"`
+	SHA1             types.String `tfsdk:"sha1"`
+}
+
+func NewPullResource() resource.Resource {
+	return &PullResource{}
+}
+
+func (r *PullResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pull"
+}
+
+func (r *PullResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.defaults = providerDefaults(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *PullResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Fetch from and integrate with a Git remote similar to 'git pull --ff-only'. Only fast-forwards are supported, thus pulling fails in case the local branch has diverged from the remote branch. Use 'git_fetch' together with 'git_merge' in order to merge diverged branches.",
+		MarkdownDescription: "Fetch from and integrate with a Git remote similar to `git pull --ff-only`. Only fast-forwards are supported, thus pulling fails in case the local branch has diverged from the remote branch. Use `git_fetch` together with `git_merge` in order to merge diverged branches.",
+		Attributes: map[string]schema.Attribute{
+			"directory": schema.StringAttribute{
+				Description:         "The path to the local Git repository.",
+				MarkdownDescription: "The path to the local Git repository.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.Int64Attribute{
+				Description:         "The timestamp of the last pull in Unix nanoseconds.",
+				MarkdownDescription: "The timestamp of the last pull in Unix nanoseconds.",
+				Computed:            true,
+			},
+			"remote_name": schema.StringAttribute{
+				Description:         "The name of the remote to pull from. Defaults to 'origin'.",
+				MarkdownDescription: "The name of the remote to pull from. Defaults to `origin`.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					modifiers.DefaultString("origin"),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reference_name": schema.StringAttribute{
+				Description:         "The name of the remote branch to pull, e.g. 'main' or 'refs/heads/main'. Defaults to the 'HEAD' of the remote.",
+				MarkdownDescription: "The name of the remote branch to pull, e.g. `main` or `refs/heads/main`. Defaults to the `HEAD` of the remote.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"single_branch": schema.BoolAttribute{
+				Description:         "Fetch only the branch specified by 'reference_name'. Defaults to 'false'.",
+				MarkdownDescription: "Fetch only the branch specified by `reference_name`. Defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"depth": schema.Int64Attribute{
+				Description:         "Limit fetching to the specified number of commits. Defaults to '0' which fetches the entire history.",
+				MarkdownDescription: "Limit fetching to the specified number of commits. Defaults to `0` which fetches the entire history.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					modifiers.DefaultInt64(0),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"force": schema.BoolAttribute{
+				Description:         "Allow updating the remote-tracking branch even when the fetched branch does not descend from it, e.g. after a force push to the remote. The local branch is still only fast-forwarded. Defaults to 'false'.",
+				MarkdownDescription: "Allow updating the remote-tracking branch even when the fetched branch does not descend from it, e.g. after a force push to the remote. The local branch is still only fast-forwarded. Defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"insecure_skip_tls": schema.BoolAttribute{
+				Description:         "Skip SSL verification if protocol is HTTPS. Defaults to the 'insecure_skip_tls' setting of the provider.",
+				MarkdownDescription: "Skip SSL verification if protocol is HTTPS. Defaults to the `insecure_skip_tls` setting of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.RequiresReplaceBoolUnlessUnset(false),
+				},
+			},
+			"ca_bundle_file_path": schema.StringAttribute{
+				Description:         "File system path to an additional CA bundle to use together with the system cert pool. Defaults to the 'ca_bundle_file_path' setting of the provider.",
+				MarkdownDescription: "File system path to an additional CA bundle to use together with the system cert pool. Defaults to the `ca_bundle_file_path` setting of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					modifiers.RequiresReplaceStringUnlessUnset(""),
+				},
+			},
+			"auth": authResourceAttribute(),
+			"previous_sha1": schema.StringAttribute{
+				Description:         "The SHA1 hash of HEAD before the pull.",
+				MarkdownDescription: "The SHA1 hash of `HEAD` before the pull.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sha1": schema.StringAttribute{
+				Description:         "The SHA1 hash of HEAD after the pull.",
+				MarkdownDescription: "The SHA1 hash of `HEAD` after the pull.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PullResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create resource git_pull")
+
+	var inputs PullResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := inputs.Directory.ValueString()
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	worktree, err := getWorktree(repository, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+	if worktree == nil {
+		resp.Diagnostics.AddError(
+			"Cannot pull into bare repository",
+			"The repository at ["+directory+"] is bare. Create a worktree first in order to pull changes into it.",
+		)
+		return
+	}
+
+	options := CreatePullOptions(ctx, &inputs, r.defaults, &resp.Diagnostics)
+	if options == nil {
+		return
+	}
+
+	var state PullResourceModel
+	state.PreviousSHA1 = types.StringNull()
+	state.SHA1 = types.StringNull()
+
+	head, err := repository.Head()
+	if err == nil {
+		state.PreviousSHA1 = types.StringValue(head.Hash().String())
+	}
+
+	err = worktree.PullContext(ctx, options)
+	if errors.Is(err, git.ErrNonFastForwardUpdate) {
+		resp.Diagnostics.AddError(
+			"Cannot fast-forward branch",
+			"Could not pull into repository ["+directory+"] because the local branch has diverged from the remote branch and cannot be fast-forwarded. "+
+				"Pulling only supports fast-forwards, use 'git_fetch' together with 'git_merge' in order to merge the diverged branches.",
+		)
+		return
+	}
+	if !errors.Is(err, git.NoErrAlreadyUpToDate) && err != nil {
+		resp.Diagnostics.AddError(
+			"Cannot pull changes",
+			"Could not pull changes into repository ["+directory+"] because of: "+err.Error(),
+		)
+		return
+	}
+
+	head, err = repository.Head()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Cannot read HEAD",
+			"Could not read HEAD of repository ["+directory+"] because of: "+err.Error(),
+		)
+		return
+	}
+	state.SHA1 = types.StringValue(head.Hash().String())
+
+	tflog.Trace(ctx, "pulled changes", map[string]interface{}{
+		"directory": directory,
+		"previous":  state.PreviousSHA1.ValueString(),
+		"current":   state.SHA1.ValueString(),
+	})
+
+	state.Directory = inputs.Directory
+	state.Id = types.Int64Value(time.Now().UnixNano())
+	state.RemoteName = inputs.RemoteName
+	state.ReferenceName = inputs.ReferenceName
+	state.SingleBranch = inputs.SingleBranch
+	state.Depth = inputs.Depth
+	state.Force = inputs.Force
+	state.InsecureSkipTls = inputs.InsecureSkipTls
+	state.CaBundleFilePath = inputs.CaBundleFilePath
+	state.Auth = inputs.Auth
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *PullResource) Read(ctx context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+	tflog.Debug(ctx, "Read resource git_pull")
+	// NO-OP: All data is already in Terraform state
+}
+
+func (r *PullResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Update resource git_pull")
+
+	var inputs PullResourceModel
+	var state PullResourceModel
+
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// all other attributes require replacement, thus only unsetting the TLS options ends up here
+	state.InsecureSkipTls = inputs.InsecureSkipTls
+	state.CaBundleFilePath = inputs.CaBundleFilePath
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PullResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Delete resource git_pull")
+	// NO-OP: Terraform removes the state automatically for us
+}
+
+func (r *PullResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	tflog.Debug(ctx, "ModifyPlan resource git_pull")
+
+	if req.State.Raw.IsNull() {
+		// if we're creating the resource, no need to modify it
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		// if we're deleting the resource, no need to modify it
+		return
+	}
+
+	var inputs PullResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := inputs.Directory.ValueString()
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	head, err := repository.Head()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Cannot read HEAD",
+			"Could not read HEAD of git repository ["+directory+"] because of: "+err.Error(),
+		)
+		return
+	}
+
+	remote := getRemote(ctx, repository, inputs.RemoteName.ValueString(), &resp.Diagnostics)
+	if remote == nil {
+		return
+	}
+
+	options := CreatePullOptions(ctx, &inputs, r.defaults, &resp.Diagnostics)
+	if options == nil {
+		return
+	}
+
+	refs, err := remote.ListContext(ctx, &git.ListOptions{
+		Auth:            options.Auth,
+		InsecureSkipTLS: options.InsecureSkipTLS,
+		CABundle:        options.CABundle,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Cannot list remote",
+			"Could not list remote ["+options.RemoteName+"] of git repository ["+directory+"] because of: "+err.Error(),
+		)
+		return
+	}
+
+	referenceName := options.ReferenceName
+	if referenceName == "" {
+		referenceName = plumbing.HEAD
+	}
+
+	remoteRef := findRemoteReference(refs, referenceName)
+	if remoteRef == nil || remoteRef.Hash() == head.Hash() {
+		return
+	}
+
+	if remoteCommit, errCommit := repository.CommitObject(remoteRef.Hash()); errCommit == nil {
+		if headCommit, errHead := repository.CommitObject(head.Hash()); errHead == nil {
+			if isAncestor, errAncestor := remoteCommit.IsAncestor(headCommit); errAncestor == nil && isAncestor {
+				// the local branch already contains the remote head, thus there is nothing to pull
+				return
+			}
+		}
+	}
+
+	sha1 := path.Root("sha1")
+	resp.Plan.SetAttribute(ctx, sha1, remoteRef.Hash().String())
+	resp.RequiresReplace = append(resp.RequiresReplace, sha1)
+}
@@ -0,0 +1,200 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/metio/terraform-provider-git/internal/testutils"
+)
+
+func TestResourceGitPull(t *testing.T) {
+	t.Parallel()
+	upstreamDirectory, upstreamRepository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, upstreamRepository)
+	upstreamWorktree := testutils.GetRepositoryWorktree(t, upstreamRepository)
+	testutils.AddAndCommitNewFile(t, upstreamWorktree, "some-file")
+	directory, repository := testutils.GitClone(t, upstreamDirectory)
+	before := testutils.GetRepositoryHead(t, repository)
+	testutils.AddAndCommitNewFile(t, upstreamWorktree, "other-file")
+	after := testutils.GetRepositoryHead(t, upstreamRepository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_pull" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_pull.test", "directory", directory),
+					resource.TestCheckResourceAttrWith("git_pull.test", "id", testutils.CheckMinLength(1)),
+					resource.TestCheckResourceAttr("git_pull.test", "remote_name", "origin"),
+					resource.TestCheckNoResourceAttr("git_pull.test", "reference_name"),
+					resource.TestCheckResourceAttr("git_pull.test", "single_branch", "false"),
+					resource.TestCheckResourceAttr("git_pull.test", "depth", "0"),
+					resource.TestCheckResourceAttr("git_pull.test", "force", "false"),
+					resource.TestCheckResourceAttr("git_pull.test", "previous_sha1", before.Hash().String()),
+					resource.TestCheckResourceAttr("git_pull.test", "sha1", after.Hash().String()),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitPull_ReferenceName(t *testing.T) {
+	t.Parallel()
+	upstreamDirectory, upstreamRepository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, upstreamRepository)
+	upstreamWorktree := testutils.GetRepositoryWorktree(t, upstreamRepository)
+	testutils.AddAndCommitNewFile(t, upstreamWorktree, "some-file")
+	directory, _ := testutils.GitClone(t, upstreamDirectory)
+	testutils.AddAndCommitNewFile(t, upstreamWorktree, "other-file")
+	after := testutils.GetRepositoryHead(t, upstreamRepository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_pull" "test" {
+						directory      = "%s"
+						reference_name = "master"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_pull.test", "reference_name", "master"),
+					resource.TestCheckResourceAttr("git_pull.test", "sha1", after.Hash().String()),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitPull_UpstreamMoved(t *testing.T) {
+	t.Parallel()
+	upstreamDirectory, upstreamRepository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, upstreamRepository)
+	upstreamWorktree := testutils.GetRepositoryWorktree(t, upstreamRepository)
+	testutils.AddAndCommitNewFile(t, upstreamWorktree, "some-file")
+	directory, _ := testutils.GitClone(t, upstreamDirectory)
+	head := testutils.GetRepositoryHead(t, upstreamRepository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_pull" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_pull.test", "previous_sha1", head.Hash().String()),
+					resource.TestCheckResourceAttr("git_pull.test", "sha1", head.Hash().String()),
+				),
+			},
+			{
+				PreConfig: func() {
+					testutils.AddAndCommitNewFile(t, upstreamWorktree, "other-file")
+				},
+				Config: fmt.Sprintf(`
+					resource "git_pull" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_pull.test", "previous_sha1", head.Hash().String()),
+					resource.TestCheckResourceAttrWith("git_pull.test", "sha1", func(value string) error {
+						upstreamHead := testutils.GetRepositoryHead(t, upstreamRepository)
+						if value != upstreamHead.Hash().String() {
+							return fmt.Errorf("expected %s but got %s", upstreamHead.Hash().String(), value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitPull_NonFastForward(t *testing.T) {
+	t.Parallel()
+	upstreamDirectory, upstreamRepository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, upstreamRepository)
+	upstreamWorktree := testutils.GetRepositoryWorktree(t, upstreamRepository)
+	testutils.AddAndCommitNewFile(t, upstreamWorktree, "some-file")
+	directory, repository := testutils.GitClone(t, upstreamDirectory)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "local-file")
+	testutils.AddAndCommitNewFile(t, upstreamWorktree, "upstream-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_pull" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot fast-forward branch`),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_pull" "test" {
+						directory = "%s"
+						force     = true
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot fast-forward branch`),
+			},
+		},
+	})
+}
+
+func TestResourceGitPull_BareRepository(t *testing.T) {
+	t.Parallel()
+	directory := testutils.CreateBareRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_pull" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot pull into bare repository`),
+			},
+		},
+	})
+}
+
+func TestResourceGitPull_Directory_Invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "git_pull" "test" {
+						directory = "/does/not/exist"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Cannot open repository`),
+			},
+		},
+	})
+}
@@ -8,19 +8,24 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/go-git/go-git/v5"
-	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/go-git/go-git/v5/config"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -28,10 +33,15 @@ import (
 	"github.com/metio/terraform-provider-git/internal/modifiers"
 )
 
-type PushResource struct{}
+type PushResource struct {
+	defaults *GitProviderModel
+}
 
 var (
-	_ resource.Resource = (*PushResource)(nil)
+	_ resource.Resource                = (*PushResource)(nil)
+	_ resource.ResourceWithConfigure   = (*PushResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*PushResource)(nil)
+	_ resource.ResourceWithImportState = (*PushResource)(nil)
 )
 
 type PushResourceModel struct {
@@ -41,9 +51,15 @@ type PushResourceModel struct {
 	RefSpecs         types.List   `tfsdk:"refspecs"`
 	Prune            types.Bool   `tfsdk:"prune"`
 	Force            types.Bool   `tfsdk:"force"`
+	Atomic           types.Bool   `tfsdk:"atomic"`
+	FollowTags       types.Bool   `tfsdk:"follow_tags"`
+	ForceWithLease   types.Object `tfsdk:"force_with_lease"`
+	Options          types.Map    `tfsdk:"options"`
 	InsecureSkipTls  types.Bool   `tfsdk:"insecure_skip_tls"`
 	CaBundleFilePath types.String `tfsdk:"ca_bundle_file_path"`
 	Auth             types.Object `tfsdk:"auth"`
+	UpdatedRefs      types.Map    `tfsdk:"updated_refs"`
+	PushedRefs       types.Map    `tfsdk:"pushed_refs"`
 }
 
 func NewPushResource() resource.Resource {
@@ -54,6 +70,10 @@ func (r *PushResource) Metadata(_ context.Context, req resource.MetadataRequest,
 	resp.TypeName = req.ProviderTypeName + "_push"
 }
 
+func (r *PushResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.defaults = providerDefaults(req.ProviderData, &resp.Diagnostics)
+}
+
 func (r *PushResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description:         "Push changes to a Git remote similar to 'git push'",
@@ -117,9 +137,9 @@ func (r *PushResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					boolplanmodifier.RequiresReplace(),
 				},
 			},
-			"insecure_skip_tls": schema.BoolAttribute{
-				Description:         "Skip SSL verification if protocol is HTTPS.",
-				MarkdownDescription: "Skip SSL verification if protocol is HTTPS.",
+			"atomic": schema.BoolAttribute{
+				Description:         "Request an atomic transaction on the remote side. Either all refs are updated, or on error, no refs are updated. Defaults to 'false'.",
+				MarkdownDescription: "Request an atomic transaction on the remote side. Either all refs are updated, or on error, no refs are updated. Defaults to `false`.",
 				Computed:            true,
 				Optional:            true,
 				PlanModifiers: []planmodifier.Bool{
@@ -127,243 +147,41 @@ func (r *PushResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					boolplanmodifier.RequiresReplace(),
 				},
 			},
-			"ca_bundle_file_path": schema.StringAttribute{
-				Description:         "File system path to an additional CA bundle to use together with the system cert pool.",
-				MarkdownDescription: "File system path to an additional CA bundle to use together with the system cert pool.",
+			"follow_tags": schema.BoolAttribute{
+				Description:         "Push all annotated tags that point to commits which are pushed as well. Defaults to 'false'.",
+				MarkdownDescription: "Push all annotated tags that point to commits which are pushed as well. Defaults to `false`.",
 				Computed:            true,
 				Optional:            true,
-				PlanModifiers: []planmodifier.String{
-					modifiers.DefaultString(""),
-					stringplanmodifier.RequiresReplace(),
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+					boolplanmodifier.RequiresReplace(),
 				},
 			},
-			"auth": schema.SingleNestedAttribute{
-				Description:         "The authentication credentials, if required, to use with the remote repository.",
-				MarkdownDescription: "The authentication credentials, if required, to use with the remote repository.",
+			"force_with_lease": schema.SingleNestedAttribute{
+				Description:         "Allow updating a remote ref that is not an ancestor of the local ref as long as the remote ref still points at the expected commit. If neither 'ref_name' nor 'sha1' is specified, all pushed refs must match their remote-tracking refs.",
+				MarkdownDescription: "Allow updating a remote ref that is not an ancestor of the local ref as long as the remote ref still points at the expected commit. If neither `ref_name` nor `sha1` is specified, all pushed refs must match their remote-tracking refs.",
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
-					"basic": schema.SingleNestedAttribute{
-						Description:         "Configure basic auth authentication.",
-						MarkdownDescription: "Configure basic auth authentication.",
-						Optional:            true,
-						Attributes: map[string]schema.Attribute{
-							"username": schema.StringAttribute{
-								Description:         "The basic auth username.",
-								MarkdownDescription: "The basic auth username.",
-								Required:            true,
-								PlanModifiers: []planmodifier.String{
-									stringplanmodifier.RequiresReplace(),
-								},
-							},
-							"password": schema.StringAttribute{
-								Description:         "The basic auth password.",
-								MarkdownDescription: "The basic auth password.",
-								Required:            true,
-								PlanModifiers: []planmodifier.String{
-									stringplanmodifier.RequiresReplace(),
-								},
-							},
-						},
-						Validators: []validator.Object{
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("bearer")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_key")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_password")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_agent")),
-							objectvalidator.ExactlyOneOf(
-								path.MatchRelative().AtParent().AtName("basic"),
-								path.MatchRelative().AtParent().AtName("bearer"),
-								path.MatchRelative().AtParent().AtName("ssh_key"),
-								path.MatchRelative().AtParent().AtName("ssh_password"),
-								path.MatchRelative().AtParent().AtName("ssh_agent"),
-							),
-						},
-						PlanModifiers: []planmodifier.Object{
-							objectplanmodifier.RequiresReplace(),
-						},
-					},
-					"bearer": schema.StringAttribute{
-						Description:         "Configure HTTP bearer token authentication. Note that services like GitHub use basic auth with your OAuth2 personal access token as the password.",
-						MarkdownDescription: "Configure HTTP bearer token authentication. **Note**: Services like GitHub use basic auth with your OAuth2 personal access token as the password.",
+					"ref_name": schema.StringAttribute{
+						Description:         "The fully qualified name of the remote ref to protect, e.g. 'refs/heads/main'. If none is specified, all pushed refs are protected.",
+						MarkdownDescription: "The fully qualified name of the remote ref to protect, e.g. `refs/heads/main`. If none is specified, all pushed refs are protected.",
 						Optional:            true,
 						Validators: []validator.String{
 							stringvalidator.LengthAtLeast(1),
-							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("basic")),
-							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_key")),
-							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_password")),
-							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_agent")),
-							stringvalidator.ExactlyOneOf(
-								path.MatchRelative().AtParent().AtName("basic"),
-								path.MatchRelative().AtParent().AtName("bearer"),
-								path.MatchRelative().AtParent().AtName("ssh_key"),
-								path.MatchRelative().AtParent().AtName("ssh_password"),
-								path.MatchRelative().AtParent().AtName("ssh_agent"),
-							),
 						},
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.RequiresReplace(),
 						},
 					},
-					"ssh_key": schema.SingleNestedAttribute{
-						Description:         "Configure SSH public/private key authentication.",
-						MarkdownDescription: "Configure SSH public/private key authentication.",
+					"sha1": schema.StringAttribute{
+						Description:         "The SHA1 hash the remote ref is expected to point at. If none is specified, the remote-tracking ref of the local repository is used.",
+						MarkdownDescription: "The SHA1 hash the remote ref is expected to point at. If none is specified, the remote-tracking ref of the local repository is used.",
 						Optional:            true,
-						Attributes: map[string]schema.Attribute{
-							"username": schema.StringAttribute{
-								Description:         "The SSH auth username.",
-								MarkdownDescription: "The SSH auth username.",
-								Optional:            true,
-								Computed:            true,
-								PlanModifiers: []planmodifier.String{
-									modifiers.DefaultString("git"),
-									stringplanmodifier.RequiresReplace(),
-								},
-							},
-							"password": schema.StringAttribute{
-								Description:         "The SSH key password.",
-								MarkdownDescription: "The SSH key password.",
-								Optional:            true,
-								Computed:            true,
-								PlanModifiers: []planmodifier.String{
-									modifiers.DefaultString(""),
-									stringplanmodifier.RequiresReplace(),
-								},
-							},
-							"private_key_path": schema.StringAttribute{
-								Description:         "The absolute path to the private SSH key.",
-								MarkdownDescription: "The absolute path to the private SSH key.",
-								Optional:            true,
-								Validators: []validator.String{
-									stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("private_key_pem")),
-									stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("private_key_pem")),
-								},
-								PlanModifiers: []planmodifier.String{
-									stringplanmodifier.RequiresReplace(),
-								},
-							},
-							"private_key_pem": schema.StringAttribute{
-								Description:         "The private SSH key in PEM format.",
-								MarkdownDescription: "The private SSH key in PEM format.",
-								Optional:            true,
-								Validators: []validator.String{
-									stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("private_key_path")),
-									stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("private_key_path")),
-								},
-								PlanModifiers: []planmodifier.String{
-									stringplanmodifier.RequiresReplace(),
-								},
-							},
-							"known_hosts": schema.SetAttribute{
-								Description:         "The list of known hosts files to accept. If none are specified, system defaults will be used.",
-								MarkdownDescription: "The list of known hosts files to accept. If none are specified, system defaults will be used.",
-								ElementType:         types.StringType,
-								Optional:            true,
-								PlanModifiers: []planmodifier.Set{
-									setplanmodifier.RequiresReplace(),
-								},
-							},
-						},
-						Validators: []validator.Object{
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("basic")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("bearer")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_password")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_agent")),
-							objectvalidator.ExactlyOneOf(
-								path.MatchRelative().AtParent().AtName("basic"),
-								path.MatchRelative().AtParent().AtName("bearer"),
-								path.MatchRelative().AtParent().AtName("ssh_key"),
-								path.MatchRelative().AtParent().AtName("ssh_password"),
-								path.MatchRelative().AtParent().AtName("ssh_agent"),
-							),
-						},
-					},
-					"ssh_password": schema.SingleNestedAttribute{
-						Description:         "Configure password based SSH authentication.",
-						MarkdownDescription: "Configure password based SSH authentication.",
-						Optional:            true,
-						Attributes: map[string]schema.Attribute{
-							"username": schema.StringAttribute{
-								Description:         "The SSH username.",
-								MarkdownDescription: "The SSH username.",
-								Required:            true,
-								PlanModifiers: []planmodifier.String{
-									stringplanmodifier.RequiresReplace(),
-								},
-							},
-							"password": schema.StringAttribute{
-								Description:         "The SSH password.",
-								MarkdownDescription: "The SSH password.",
-								Required:            true,
-								PlanModifiers: []planmodifier.String{
-									stringplanmodifier.RequiresReplace(),
-								},
-							},
-							"known_hosts": schema.SetAttribute{
-								Description:         "The list of known hosts files to accept. If none are specified, system defaults will be used.",
-								MarkdownDescription: "The list of known hosts files to accept. If none are specified, system defaults will be used.",
-								ElementType:         types.StringType,
-								Optional:            true,
-								PlanModifiers: []planmodifier.Set{
-									setplanmodifier.RequiresReplace(),
-								},
-							},
-						},
-						Validators: []validator.Object{
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("basic")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("bearer")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_key")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_agent")),
-							objectvalidator.ExactlyOneOf(
-								path.MatchRelative().AtParent().AtName("basic"),
-								path.MatchRelative().AtParent().AtName("bearer"),
-								path.MatchRelative().AtParent().AtName("ssh_key"),
-								path.MatchRelative().AtParent().AtName("ssh_password"),
-								path.MatchRelative().AtParent().AtName("ssh_agent"),
-							),
-						},
-						PlanModifiers: []planmodifier.Object{
-							objectplanmodifier.RequiresReplace(),
-						},
-					},
-					"ssh_agent": schema.SingleNestedAttribute{
-						Description:         "Configure SSH agent based authentication.",
-						MarkdownDescription: "Configure SSH agent based authentication.",
-						Optional:            true,
-						Attributes: map[string]schema.Attribute{
-							"username": schema.StringAttribute{
-								Description:         "The system username of the user talking to the SSH agent. Use an empty string in order to automatically fetch this.",
-								MarkdownDescription: "The system username of the user talking to the SSH agent. Use an empty string in order to automatically fetch this.",
-								Optional:            true,
-								Computed:            true,
-								PlanModifiers: []planmodifier.String{
-									modifiers.DefaultString(""),
-									stringplanmodifier.RequiresReplace(),
-								},
-							},
-							"known_hosts": schema.SetAttribute{
-								Description:         "The list of known hosts files to accept. If none are specified, system defaults will be used.",
-								MarkdownDescription: "The list of known hosts files to accept. If none are specified, system defaults will be used.",
-								ElementType:         types.StringType,
-								Optional:            true,
-								PlanModifiers: []planmodifier.Set{
-									setplanmodifier.RequiresReplace(),
-								},
-							},
-						},
-						Validators: []validator.Object{
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("basic")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("bearer")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_key")),
-							objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_password")),
-							objectvalidator.ExactlyOneOf(
-								path.MatchRelative().AtParent().AtName("basic"),
-								path.MatchRelative().AtParent().AtName("bearer"),
-								path.MatchRelative().AtParent().AtName("ssh_key"),
-								path.MatchRelative().AtParent().AtName("ssh_password"),
-								path.MatchRelative().AtParent().AtName("ssh_agent"),
-							),
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9a-f]{40}$`), "must be a full SHA1 hash"),
 						},
-						PlanModifiers: []planmodifier.Object{
-							objectplanmodifier.RequiresReplace(),
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
 						},
 					},
 				},
@@ -371,6 +189,57 @@ func (r *PushResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					objectplanmodifier.RequiresReplace(),
 				},
 			},
+			"options": schema.MapAttribute{
+				Description:         "The push options to transmit to the server similar to 'git push --push-option'.",
+				MarkdownDescription: "The push options to transmit to the server similar to `git push --push-option`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"insecure_skip_tls": schema.BoolAttribute{
+				Description:         "Skip SSL verification if protocol is HTTPS. Defaults to the 'insecure_skip_tls' setting of the provider.",
+				MarkdownDescription: "Skip SSL verification if protocol is HTTPS. Defaults to the `insecure_skip_tls` setting of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.RequiresReplaceBoolUnlessUnset(false),
+				},
+			},
+			"ca_bundle_file_path": schema.StringAttribute{
+				Description:         "File system path to an additional CA bundle to use together with the system cert pool. Defaults to the 'ca_bundle_file_path' setting of the provider.",
+				MarkdownDescription: "File system path to an additional CA bundle to use together with the system cert pool. Defaults to the `ca_bundle_file_path` setting of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					modifiers.RequiresReplaceStringUnlessUnset(""),
+				},
+			},
+			"auth": authResourceAttribute(),
+			"updated_refs": schema.MapNestedAttribute{
+				Description:         "The remote refs that were created, updated or deleted by the last push.",
+				MarkdownDescription: "The remote refs that were created, updated or deleted by the last push.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"old_sha1": schema.StringAttribute{
+							Description:         "The SHA1 hash of the remote ref before the push. Not set in case the ref was created.",
+							MarkdownDescription: "The SHA1 hash of the remote ref before the push. Not set in case the ref was created.",
+							Computed:            true,
+						},
+						"new_sha1": schema.StringAttribute{
+							Description:         "The SHA1 hash of the remote ref after the push. Not set in case the ref was deleted.",
+							MarkdownDescription: "The SHA1 hash of the remote ref after the push. Not set in case the ref was deleted.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"pushed_refs": schema.MapAttribute{
+				Description:         "The SHA1 hashes of the local refs matched by 'refspecs' during the last push keyed by the name of the remote ref they were pushed into. Another push is planned once a local ref is ahead of its remote counterpart.",
+				MarkdownDescription: "The SHA1 hashes of the local refs matched by `refspecs` during the last push keyed by the name of the remote ref they were pushed into. Another push is planned once a local ref is ahead of its remote counterpart.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -392,11 +261,21 @@ func (r *PushResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	options := CreatePushOptions(ctx, &inputs, &resp.Diagnostics)
+	options := CreatePushOptions(ctx, &inputs, r.defaults, &resp.Diagnostics)
 	if options == nil {
 		return
 	}
 
+	remote := getRemote(ctx, repository, options.RemoteName, &resp.Diagnostics)
+	if remote == nil {
+		return
+	}
+
+	before := getRemoteReferenceHashes(ctx, remote, options, &resp.Diagnostics)
+	if before == nil {
+		return
+	}
+
 	err := repository.PushContext(ctx, options)
 	if !errors.Is(err, git.NoErrAlreadyUpToDate) && err != nil {
 		resp.Diagnostics.AddError(
@@ -406,6 +285,44 @@ func (r *PushResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	after := getRemoteReferenceHashes(ctx, remote, options, &resp.Diagnostics)
+	if after == nil {
+		return
+	}
+
+	localHashes := getReferenceHashes(ctx, repository, &resp.Diagnostics)
+	if localHashes == nil {
+		return
+	}
+
+	updatedRefs := make(map[string]attr.Value)
+	for name, hash := range after {
+		if previous, ok := before[name]; !ok || previous != hash {
+			updatedRefs[name.String()] = types.ObjectValueMust(updatedRefType, map[string]attr.Value{
+				"old_sha1": hashValue(before, name),
+				"new_sha1": types.StringValue(hash.String()),
+			})
+		}
+	}
+	for name := range before {
+		if _, ok := after[name]; !ok {
+			updatedRefs[name.String()] = types.ObjectValueMust(updatedRefType, map[string]attr.Value{
+				"old_sha1": hashValue(before, name),
+				"new_sha1": types.StringNull(),
+			})
+		}
+	}
+
+	pushedRefs := make(map[string]attr.Value)
+	for name, hash := range getPushedReferences(options.RefSpecs, localHashes) {
+		pushedRefs[name.String()] = types.StringValue(hash.String())
+	}
+
+	tflog.Trace(ctx, "pushed refs", map[string]interface{}{
+		"directory": directory,
+		"updated":   len(updatedRefs),
+	})
+
 	var state PushResourceModel
 	state.Directory = inputs.Directory
 	state.Id = types.Int64Value(time.Now().UnixNano())
@@ -413,9 +330,16 @@ func (r *PushResource) Create(ctx context.Context, req resource.CreateRequest, r
 	state.RefSpecs = inputs.RefSpecs
 	state.Prune = inputs.Prune
 	state.Force = inputs.Force
+	state.Atomic = inputs.Atomic
+	state.FollowTags = inputs.FollowTags
+	state.ForceWithLease = inputs.ForceWithLease
+	state.Options = inputs.Options
 	state.InsecureSkipTls = inputs.InsecureSkipTls
 	state.CaBundleFilePath = inputs.CaBundleFilePath
 	state.Auth = inputs.Auth
+	state.UpdatedRefs, diags = types.MapValue(types.ObjectType{AttrTypes: updatedRefType}, updatedRefs)
+	resp.Diagnostics.Append(diags...)
+	state.PushedRefs = types.MapValueMust(types.StringType, pushedRefs)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -429,12 +353,170 @@ func (r *PushResource) Read(ctx context.Context, _ resource.ReadRequest, _ *reso
 	// NO-OP: All data is already in Terraform state
 }
 
-func (r *PushResource) Update(ctx context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+func (r *PushResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	tflog.Debug(ctx, "Update resource git_push")
-	// NO-OP: All attributes require replacement, thus delete/create will be called
+
+	var inputs PushResourceModel
+	var state PushResourceModel
+
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// all other attributes require replacement, thus only unsetting the TLS options ends up here
+	state.InsecureSkipTls = inputs.InsecureSkipTls
+	state.CaBundleFilePath = inputs.CaBundleFilePath
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *PushResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
 	tflog.Debug(ctx, "Delete resource git_push")
 	// NO-OP: Terraform removes the state automatically for us
 }
+
+func (r *PushResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "ImportState resource git_push")
+
+	id := req.ID
+	idParts := strings.Split(id, "|")
+
+	if len(idParts) < 3 || slices.Contains(idParts, "") {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: 'path/to/your/git/repository|name-of-your-remote|refspec' Got: %q", id),
+		)
+		return
+	}
+
+	directory := idParts[0]
+	remoteName := idParts[1]
+	refSpecs := idParts[2:]
+
+	for _, refSpec := range refSpecs {
+		if err := config.RefSpec(refSpec).Validate(); err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid refspec",
+				"The refspec ["+refSpec+"] is invalid because of: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	remote := getRemote(ctx, repository, remoteName, &resp.Diagnostics)
+	if remote == nil {
+		return
+	}
+
+	var state PushResourceModel
+	state.Directory = types.StringValue(directory)
+	state.Id = types.Int64Value(time.Now().UnixNano())
+	state.Remote = types.StringValue(remoteName)
+	state.RefSpecs, _ = types.ListValueFrom(ctx, types.StringType, refSpecs)
+	state.Prune = types.BoolValue(false)
+	state.Force = types.BoolValue(false)
+	state.Atomic = types.BoolValue(false)
+	state.FollowTags = types.BoolValue(false)
+	state.ForceWithLease = types.ObjectNull(map[string]attr.Type{
+		"ref_name": types.StringType,
+		"sha1":     types.StringType,
+	})
+	state.Options = types.MapNull(types.StringType)
+	state.InsecureSkipTls = types.BoolNull()
+	state.CaBundleFilePath = types.StringNull()
+	state.Auth = types.ObjectNull(authResourceAttribute().GetType().(types.ObjectType).AttrTypes)
+	state.UpdatedRefs = types.MapValueMust(types.ObjectType{AttrTypes: updatedRefType}, map[string]attr.Value{})
+	state.PushedRefs = types.MapValueMust(types.StringType, map[string]attr.Value{})
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *PushResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	tflog.Debug(ctx, "ModifyPlan resource git_push")
+
+	if req.State.Raw.IsNull() {
+		// if we're creating the resource, no need to modify it
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		// if we're deleting the resource, no need to modify it
+		return
+	}
+
+	var inputs PushResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := inputs.Directory.ValueString()
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	options := CreatePushOptions(ctx, &inputs, r.defaults, &resp.Diagnostics)
+	if options == nil {
+		return
+	}
+
+	remote := getRemote(ctx, repository, options.RemoteName, &resp.Diagnostics)
+	if remote == nil {
+		return
+	}
+
+	localHashes := getReferenceHashes(ctx, repository, &resp.Diagnostics)
+	if localHashes == nil {
+		return
+	}
+
+	remoteHashes := getRemoteReferenceHashes(ctx, remote, options, &resp.Diagnostics)
+	if remoteHashes == nil {
+		return
+	}
+
+	unpushed := getUnpushedReferences(repository, options.RefSpecs, localHashes, remoteHashes)
+	if len(unpushed) == 0 {
+		return
+	}
+
+	for name, hash := range unpushed {
+		remoteHash := hashValue(remoteHashes, name)
+		tflog.Trace(ctx, "local ref moved", map[string]interface{}{
+			"ref":    name.String(),
+			"local":  hash.String(),
+			"remote": remoteHash.ValueString(),
+		})
+		current := "does not exist"
+		if !remoteHash.IsNull() {
+			current = "points at [" + remoteHash.ValueString() + "]"
+		}
+		resp.Diagnostics.AddWarning(
+			"Unpushed changes",
+			"The ref ["+name.String()+"] of remote ["+options.RemoteName+"] "+current+" while the local repository ["+directory+"] will push ["+hash.String()+"].",
+		)
+	}
+
+	// the refs actually updated by the push are only known afterwards, e.g. in case 'follow_tags' or 'prune' are set
+	resp.Plan.SetAttribute(ctx, path.Root("updated_refs"), types.MapUnknown(types.ObjectType{AttrTypes: updatedRefType}))
+	pushedRefsPath := path.Root("pushed_refs")
+	resp.Plan.SetAttribute(ctx, pushedRefsPath, types.MapUnknown(types.StringType))
+	resp.RequiresReplace = append(resp.RequiresReplace, pushedRefsPath)
+}
@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/go-git/go-git/v5"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/metio/terraform-provider-git/internal/testutils"
 )
@@ -46,6 +47,13 @@ func TestResourceGitPush(t *testing.T) {
 					resource.TestCheckResourceAttr("git_push.test", "refspecs.0", "refs/heads/master:refs/heads/master"),
 					resource.TestCheckResourceAttr("git_push.test", "prune", "false"),
 					resource.TestCheckResourceAttr("git_push.test", "force", "false"),
+					resource.TestCheckResourceAttr("git_push.test", "atomic", "false"),
+					resource.TestCheckResourceAttr("git_push.test", "follow_tags", "false"),
+					resource.TestCheckResourceAttr("git_push.test", "updated_refs.%", "1"),
+					resource.TestCheckNoResourceAttr("git_push.test", "updated_refs.refs/heads/master.old_sha1"),
+					resource.TestCheckResourceAttr("git_push.test", "updated_refs.refs/heads/master.new_sha1", head.Hash().String()),
+					resource.TestCheckResourceAttr("git_push.test", "pushed_refs.%", "1"),
+					resource.TestCheckResourceAttr("git_push.test", "pushed_refs.refs/heads/master", head.Hash().String()),
 					resource.TestCheckResourceAttr("data.git_repository.second", "sha1", head.Hash().String()),
 				),
 			},
@@ -53,6 +61,223 @@ func TestResourceGitPush(t *testing.T) {
 	})
 }
 
+func TestResourceGitPush_UnpushedCommits(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	directory2 := testutils.CreateBareRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.CreateRemoteWithUrls(t, repository, "origin", []string{filepath.FromSlash(directory2)})
+	first := testutils.GetRepositoryHead(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_push" "test" {
+						directory = "%s"
+						refspecs  = ["refs/heads/*:refs/heads/*"]
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_push.test", "updated_refs.refs/heads/master.new_sha1", first.Hash().String()),
+				),
+			},
+			{
+				PreConfig: func() {
+					testutils.AddAndCommitNewFile(t, worktree, "other-file")
+				},
+				Config: fmt.Sprintf(`
+					resource "git_push" "test" {
+						directory = "%s"
+						refspecs  = ["refs/heads/*:refs/heads/*"]
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_push.test", "updated_refs.refs/heads/master.old_sha1", first.Hash().String()),
+					resource.TestCheckResourceAttrWith("git_push.test", "updated_refs.refs/heads/master.new_sha1", func(value string) error {
+						head := testutils.GetRepositoryHead(t, repository)
+						if value != head.Hash().String() {
+							return fmt.Errorf("expected %s but got %s", head.Hash().String(), value)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_push" "test" {
+						directory = "%s"
+						refspecs  = ["refs/heads/*:refs/heads/*"]
+					}
+				`, directory),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceGitPush_RemoteAhead(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	directory2 := testutils.CreateBareRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.CreateRemoteWithUrls(t, repository, "origin", []string{filepath.FromSlash(directory2)})
+	first := testutils.GetRepositoryHead(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_push" "test" {
+						directory = "%s"
+						refspecs  = ["refs/heads/*:refs/heads/*"]
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_push.test", "pushed_refs.refs/heads/master", first.Hash().String()),
+				),
+			},
+			{
+				PreConfig: func() {
+					testutils.AddAndCommitNewFile(t, worktree, "other-file")
+					testutils.GitPush(t, repository, "refs/heads/master:refs/heads/master")
+					err := worktree.Reset(&git.ResetOptions{
+						Commit: first.Hash(),
+						Mode:   git.HardReset,
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: fmt.Sprintf(`
+					resource "git_push" "test" {
+						directory = "%s"
+						refspecs  = ["refs/heads/*:refs/heads/*"]
+					}
+				`, directory),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceGitPush_FollowTags(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	directory2 := testutils.CreateBareRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.CreateTag(t, repository, "v1.0.0")
+	testutils.CreateRemoteWithUrls(t, repository, "origin", []string{filepath.FromSlash(directory2)})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_push" "test" {
+						directory   = "%s"
+						refspecs    = ["refs/heads/master:refs/heads/master"]
+						follow_tags = true
+						atomic      = true
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_push.test", "follow_tags", "true"),
+					resource.TestCheckResourceAttr("git_push.test", "atomic", "true"),
+					resource.TestCheckResourceAttr("git_push.test", "updated_refs.%", "2"),
+					resource.TestCheckResourceAttrWith("git_push.test", "updated_refs.refs/tags/v1.0.0.new_sha1", testutils.CheckExactLength(40)),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitPush_ForceWithLease(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	directory2 := testutils.CreateBareRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.CreateRemoteWithUrls(t, repository, "origin", []string{filepath.FromSlash(directory2)})
+	testutils.GitPush(t, repository, "refs/heads/master:refs/heads/master")
+	pushed := testutils.GetRepositoryHead(t, repository)
+	testutils.WriteFileInWorktree(t, worktree, "other-file")
+	testutils.GitAdd(t, worktree, "other-file")
+	testutils.GitCommitWith(t, worktree, &git.CommitOptions{
+		Author: testutils.Signature(),
+		Amend:  true,
+	})
+	head := testutils.GetRepositoryHead(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_push" "test" {
+						directory        = "%s"
+						refspecs         = ["refs/heads/master:refs/heads/master"]
+						force_with_lease = {
+							ref_name = "refs/heads/master"
+							sha1     = "%s"
+						}
+					}
+				`, directory, pushed.Hash().String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_push.test", "updated_refs.refs/heads/master.old_sha1", pushed.Hash().String()),
+					resource.TestCheckResourceAttr("git_push.test", "updated_refs.refs/heads/master.new_sha1", head.Hash().String()),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitPush_ForceWithLease_Rejected(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	directory2 := testutils.CreateBareRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.CreateRemoteWithUrls(t, repository, "origin", []string{filepath.FromSlash(directory2)})
+	testutils.GitPush(t, repository, "refs/heads/master:refs/heads/master")
+	testutils.WriteFileInWorktree(t, worktree, "other-file")
+	testutils.GitAdd(t, worktree, "other-file")
+	testutils.GitCommitWith(t, worktree, &git.CommitOptions{
+		Author: testutils.Signature(),
+		Amend:  true,
+	})
+	head := testutils.GetRepositoryHead(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_push" "test" {
+						directory        = "%s"
+						refspecs         = ["refs/heads/master:refs/heads/master"]
+						force_with_lease = {
+							ref_name = "refs/heads/master"
+							sha1     = "%s"
+						}
+					}
+				`, directory, head.Hash().String()),
+				ExpectError: regexp.MustCompile(`non-fast-forward update`),
+			},
+		},
+	})
+}
+
 func TestResourceGitPush_RefSpecs_Invalid(t *testing.T) {
 	t.Parallel()
 	directory, _ := testutils.CreateRepository(t)
@@ -549,3 +774,75 @@ func TestResourceGitPush_Auth_Invalid_Empty(t *testing.T) {
 		},
 	})
 }
+
+func TestResourceGitPush_Import(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	directory2 := testutils.CreateBareRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.CreateRemoteWithUrls(t, repository, "origin", []string{filepath.FromSlash(directory2)})
+	testutils.GitPush(t, repository, "refs/heads/master:refs/heads/master")
+	refSpec := "refs/heads/master:refs/heads/master"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_push" "test" {
+						directory = "%s"
+						refspecs  = ["%s"]
+					}
+				`, directory, refSpec),
+				ResourceName:       "git_push.test",
+				ImportState:        true,
+				ImportStateId:      fmt.Sprintf("%s|%s|%s", directory, "origin", refSpec),
+				ImportStatePersist: true,
+				ImportStateCheck: testutils.ComposeImportStateCheck(
+					testutils.CheckResourceAttrInstanceState("directory", directory),
+					testutils.CheckResourceAttrInstanceState("remote", "origin"),
+					testutils.CheckResourceAttrInstanceState("refspecs.#", "1"),
+					testutils.CheckResourceAttrInstanceState("refspecs.0", refSpec),
+					testutils.CheckResourceAttrInstanceState("prune", "false"),
+					testutils.CheckResourceAttrInstanceState("force", "false"),
+					testutils.CheckResourceAttrInstanceState("updated_refs.%", "0"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_push" "test" {
+						directory = "%s"
+						refspecs  = ["%s"]
+					}
+				`, directory, refSpec),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceGitPush_Import_InvalidIdentifier(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_push" "test" {
+						directory = "%s"
+						refspecs  = ["refs/heads/master:refs/heads/master"]
+					}
+				`, directory),
+				ResourceName:       "git_push.test",
+				ImportState:        true,
+				ImportStateId:      fmt.Sprintf("%s|%s", directory, "origin"),
+				ImportStatePersist: false,
+				ExpectError:        regexp.MustCompile(`Unexpected import identifier`),
+			},
+		},
+	})
+}
@@ -0,0 +1,339 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/metio/terraform-provider-git/internal/modifiers"
+)
+
+type ResetResource struct{}
+
+var (
+	_ resource.Resource               = (*ResetResource)(nil)
+	_ resource.ResourceWithModifyPlan = (*ResetResource)(nil)
+)
+
+type resetResourceModel struct {
+	Directory    types.String `tfsdk:"directory"`
+	Id           types.String `tfsdk:"id"`
+	Revision     types.String `tfsdk:"revision"`
+	Mode         types.String `tfsdk:"mode"`
+	Files        types.List   `tfsdk:"files"`
+	Force        types.Bool   `tfsdk:"force"`
+	OldSHA1      types.String `tfsdk:"old_sha1"`
+	NewSHA1      types.String `tfsdk:"new_sha1"`
+	RevisionSHA1 types.String `tfsdk:"revision_sha1"`
+}
+
+func NewResetResource() resource.Resource {
+	return &ResetResource{}
+}
+
+func (r *ResetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reset"
+}
+
+func (r *ResetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Reset the current 'HEAD', the index, and the worktree to a specified state similar to 'git reset'. The reset is performed again whenever 'HEAD' no longer points to the commit it was reset to or the index and the worktree contain changes the mode would reset.",
+		MarkdownDescription: "Reset the current `HEAD`, the index, and the worktree to a specified state similar to `git reset`. The reset is performed again whenever `HEAD` no longer points to the commit it was reset to or the index and the worktree contain changes the mode would reset.",
+		Attributes: map[string]schema.Attribute{
+			"directory": schema.StringAttribute{
+				Description:         "The path to the local Git repository.",
+				MarkdownDescription: "The path to the local Git repository.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description:         "The same value as the 'directory' attribute.",
+				MarkdownDescription: "The same value as the `directory` attribute.",
+				Computed:            true,
+			},
+			"revision": schema.StringAttribute{
+				Description:         "The revision to reset to. The revision is resolved once during the reset, thus revisions relative to 'HEAD' like 'HEAD~1' do not move 'HEAD' any further during subsequent plans. Note that 'go-git' does not support every revision type at the moment. Defaults to 'HEAD'.",
+				MarkdownDescription: "The [revision](https://www.git-scm.com/docs/gitrevisions) to reset to. The revision is resolved once during the reset, thus revisions relative to `HEAD` like `HEAD~1` do not move `HEAD` any further during subsequent plans. Note that `go-git` does not [support](https://pkg.go.dev/github.com/go-git/go-git/v5#Repository.ResolveRevision) every revision type at the moment. Defaults to `HEAD`.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					modifiers.DefaultString("HEAD"),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Description:         "The kind of reset to perform. Possible values are 'soft' to only move 'HEAD', 'mixed' to reset the index as well, 'hard' to reset the index and the tracked files in the worktree, and 'merge' to reset the index and the files which differ between 'HEAD' and the revision. Defaults to 'mixed'.",
+				MarkdownDescription: "The kind of reset to perform. Possible values are `soft` to only move `HEAD`, `mixed` to reset the index as well, `hard` to reset the index and the tracked files in the worktree, and `merge` to reset the index and the files which differ between `HEAD` and the revision. Defaults to `mixed`.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("soft", "mixed", "hard", "merge"),
+				},
+				PlanModifiers: []planmodifier.String{
+					modifiers.DefaultString("mixed"),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"files": schema.ListAttribute{
+				Description:         "The files or directories to reset. 'HEAD' is not moved in case files are specified. Can only be used with the 'mixed' and 'hard' modes.",
+				MarkdownDescription: "The files or directories to reset. `HEAD` is not moved in case files are specified. Can only be used with the `mixed` and `hard` modes.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"force": schema.BoolAttribute{
+				Description:         "Proceed even if a 'hard' reset discards uncommitted changes in the index or the worktree. Untracked files are never removed. Defaults to 'false'.",
+				MarkdownDescription: "Proceed even if a `hard` reset discards uncommitted changes in the index or the worktree. Untracked files are never removed. Defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"old_sha1": schema.StringAttribute{
+				Description:         "The SHA1 hash of 'HEAD' before the reset.",
+				MarkdownDescription: "The SHA1 hash of `HEAD` before the reset.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"new_sha1": schema.StringAttribute{
+				Description:         "The SHA1 hash of 'HEAD' after the reset.",
+				MarkdownDescription: "The SHA1 hash of `HEAD` after the reset.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"revision_sha1": schema.StringAttribute{
+				Description:         "The SHA1 hash of the commit 'revision' resolved to during the reset.",
+				MarkdownDescription: "The SHA1 hash of the commit `revision` resolved to during the reset.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ResetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create resource git_reset")
+
+	var inputs resetResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := inputs.Directory.ValueString()
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	worktree, err := getWorktree(repository, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+	if worktree == nil {
+		resp.Diagnostics.AddError(
+			"Cannot reset bare repository",
+			"The repository at ["+directory+"] is bare. Create a worktree first in order to reset it.",
+		)
+		return
+	}
+
+	commit := resolveRevision(ctx, repository, inputs.Revision.ValueString(), &resp.Diagnostics)
+	if commit == nil {
+		return
+	}
+
+	options := createResetOptions(ctx, repository, inputs, *commit, &resp.Diagnostics)
+	if options == nil {
+		return
+	}
+
+	status := getStatus(ctx, worktree, &resp.Diagnostics)
+	if status == nil {
+		return
+	}
+	if discarded := discardedChanges(status, options); len(discarded) > 0 && !inputs.Force.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Cannot discard local changes",
+			"Resetting repository ["+directory+"] would discard the uncommitted changes in ["+strings.Join(discarded, ", ")+"]. "+
+				"Commit them first or set 'force' to 'true' in order to discard them.",
+		)
+		return
+	}
+
+	head, err := repository.Head()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Cannot read HEAD",
+			"Could not read HEAD of repository ["+directory+"] because of: "+err.Error(),
+		)
+		return
+	}
+
+	pathLimited := len(inputs.Files.Elements()) > 0
+	if !resetWorktree(ctx, repository, worktree, options, pathLimited, &resp.Diagnostics) {
+		return
+	}
+
+	newHead, err := repository.Head()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Cannot read HEAD",
+			"Could not read HEAD of repository ["+directory+"] because of: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "reset repository", map[string]interface{}{
+		"directory": directory,
+		"old":       head.Hash().String(),
+		"new":       newHead.Hash().String(),
+	})
+
+	var state resetResourceModel
+	state.Directory = inputs.Directory
+	state.Id = inputs.Directory
+	state.Revision = inputs.Revision
+	state.Mode = inputs.Mode
+	state.Files = inputs.Files
+	state.Force = inputs.Force
+	state.OldSHA1 = types.StringValue(head.Hash().String())
+	state.NewSHA1 = types.StringValue(newHead.Hash().String())
+	state.RevisionSHA1 = types.StringValue(commit.String())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ResetResource) Read(ctx context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+	tflog.Debug(ctx, "Read resource git_reset")
+	// NO-OP: All data is already in Terraform state
+}
+
+func (r *ResetResource) Update(ctx context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Update resource git_reset")
+	// NO-OP: All attributes require replacement, thus delete/create will be called
+}
+
+func (r *ResetResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Delete resource git_reset")
+	// NO-OP: Terraform removes the state automatically for us
+}
+
+func (r *ResetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	tflog.Debug(ctx, "ModifyPlan resource git_reset")
+
+	if req.State.Raw.IsNull() {
+		// if we're creating the resource, no need to modify it
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		// if we're deleting the resource, no need to modify it
+		return
+	}
+
+	var inputs resetResourceModel
+	var state resetResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repository := openRepository(ctx, inputs.Directory.ValueString(), &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	worktree, err := getWorktree(repository, &resp.Diagnostics)
+	if err != nil || worktree == nil {
+		return
+	}
+
+	// the revision is not resolved again since revisions like 'HEAD~1' would move HEAD during every apply
+	commit := plumbing.NewHash(state.RevisionSHA1.ValueString())
+	options := createResetOptions(ctx, repository, inputs, commit, &resp.Diagnostics)
+	if options == nil {
+		return
+	}
+
+	head, err := repository.Head()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Cannot read HEAD",
+			"Could not read HEAD because of: "+err.Error(),
+		)
+		return
+	}
+
+	status := getStatus(ctx, worktree, &resp.Diagnostics)
+	if status == nil {
+		return
+	}
+
+	pathLimited := len(inputs.Files.Elements()) > 0
+	resettable := false
+	switch {
+	case head.Hash().String() != state.NewSHA1.ValueString():
+		// path limited resets never move HEAD, thus only full resets have to be performed again
+		resettable = !pathLimited
+	case head.Hash() == commit:
+		// the status is relative to HEAD, thus local changes can only be detected while HEAD points to the revision
+		resettable = hasResettableChanges(status, options)
+	}
+	if resettable {
+		// someone changed HEAD, the index or the worktree by hand, thus we have to reset again
+		newSHA1 := path.Root("new_sha1")
+		resp.Plan.SetAttribute(ctx, path.Root("old_sha1"), types.StringUnknown())
+		resp.Plan.SetAttribute(ctx, newSHA1, types.StringUnknown())
+		resp.Plan.SetAttribute(ctx, path.Root("revision_sha1"), types.StringUnknown())
+		resp.RequiresReplace = append(resp.RequiresReplace, newSHA1)
+	}
+}
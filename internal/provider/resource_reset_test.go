@@ -0,0 +1,342 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/metio/terraform-provider-git/internal/testutils"
+)
+
+func TestResourceGitReset(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	name := "some-file"
+	testutils.AddAndCommitNewFile(t, worktree, name)
+	head := testutils.GetRepositoryHead(t, repository)
+	testutils.WriteFileContent(t, testutils.FileInWorktree(worktree, name), "changed")
+	testutils.GitAdd(t, worktree, name)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_reset" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_reset.test", "directory", directory),
+					resource.TestCheckResourceAttr("git_reset.test", "id", directory),
+					resource.TestCheckResourceAttr("git_reset.test", "revision", "HEAD"),
+					resource.TestCheckResourceAttr("git_reset.test", "mode", "mixed"),
+					resource.TestCheckNoResourceAttr("git_reset.test", "files"),
+					resource.TestCheckResourceAttr("git_reset.test", "force", "false"),
+					resource.TestCheckResourceAttr("git_reset.test", "old_sha1", head.Hash().String()),
+					resource.TestCheckResourceAttr("git_reset.test", "new_sha1", head.Hash().String()),
+					resource.TestCheckResourceAttr("git_reset.test", "revision_sha1", head.Hash().String()),
+					testutils.CheckFileContent(worktree, name, "changed"),
+					func(_ *terraform.State) error {
+						status, err := worktree.Status()
+						if err != nil {
+							return err
+						}
+						if status.File(name).Staging != git.Unmodified {
+							return fmt.Errorf("expected '%s' to be unstaged, got: %s", name, status.String())
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitReset_Soft(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	first := testutils.GetRepositoryHead(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	second := testutils.GetRepositoryHead(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_reset" "test" {
+						directory = "%s"
+						revision  = "%s"
+						mode      = "soft"
+					}
+				`, directory, first.Hash().String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_reset.test", "mode", "soft"),
+					resource.TestCheckResourceAttr("git_reset.test", "old_sha1", second.Hash().String()),
+					resource.TestCheckResourceAttr("git_reset.test", "new_sha1", first.Hash().String()),
+					testutils.CheckFileContent(worktree, "other-file", "hello world!"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitReset_RelativeRevision(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	first := testutils.GetRepositoryHead(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	second := testutils.GetRepositoryHead(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_reset" "test" {
+						directory = "%s"
+						revision  = "HEAD~1"
+						mode      = "soft"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_reset.test", "old_sha1", second.Hash().String()),
+					resource.TestCheckResourceAttr("git_reset.test", "new_sha1", first.Hash().String()),
+					resource.TestCheckResourceAttr("git_reset.test", "revision_sha1", first.Hash().String()),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_reset" "test" {
+						directory = "%s"
+						revision  = "HEAD~1"
+						mode      = "soft"
+					}
+				`, directory),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceGitReset_Hard(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	first := testutils.GetRepositoryHead(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	testutils.WriteFileInWorktree(t, worktree, "untracked-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_reset" "test" {
+						directory = "%s"
+						revision  = "%s"
+						mode      = "hard"
+					}
+				`, directory, first.Hash().String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_reset.test", "mode", "hard"),
+					resource.TestCheckResourceAttr("git_reset.test", "new_sha1", first.Hash().String()),
+					testutils.CheckFileContent(worktree, "untracked-file", "hello world!"),
+					func(_ *terraform.State) error {
+						if _, err := worktree.Filesystem.Stat("other-file"); err == nil {
+							return fmt.Errorf("expected 'other-file' to be removed")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitReset_Hard_LocalChanges(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	name := "some-file"
+	testutils.AddAndCommitNewFile(t, worktree, name)
+	testutils.WriteFileContent(t, testutils.FileInWorktree(worktree, name), "changed")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_reset" "test" {
+						directory = "%s"
+						mode      = "hard"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot discard local changes`),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_reset" "test" {
+						directory = "%s"
+						mode      = "hard"
+						force     = true
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_reset.test", "force", "true"),
+					testutils.CheckFileContent(worktree, name, "hello world!"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitReset_Files(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	first := testutils.GetRepositoryHead(t, repository)
+	testutils.WriteFileContent(t, testutils.FileInWorktree(worktree, "some-file"), "changed")
+	testutils.GitAdd(t, worktree, "some-file")
+	testutils.GitCommit(t, worktree)
+	second := testutils.GetRepositoryHead(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_reset" "test" {
+						directory = "%s"
+						revision  = "%s"
+						mode      = "hard"
+						files     = ["some-file"]
+					}
+				`, directory, first.Hash().String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_reset.test", "files.#", "1"),
+					resource.TestCheckResourceAttr("git_reset.test", "files.0", "some-file"),
+					resource.TestCheckResourceAttr("git_reset.test", "old_sha1", second.Hash().String()),
+					resource.TestCheckResourceAttr("git_reset.test", "new_sha1", second.Hash().String()),
+					testutils.CheckFileContent(worktree, "some-file", "hello world!"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitReset_Files_Soft(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_reset" "test" {
+						directory = "%s"
+						mode      = "soft"
+						files     = ["some-file"]
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Invalid reset options`),
+			},
+		},
+	})
+}
+
+func TestResourceGitReset_LocalChangesAfterReset(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	name := "some-file"
+	testutils.AddAndCommitNewFile(t, worktree, name)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_reset" "test" {
+						directory = "%s"
+						mode      = "hard"
+						force     = true
+					}
+				`, directory),
+			},
+			{
+				PreConfig: func() {
+					testutils.WriteFileContent(t, testutils.FileInWorktree(worktree, name), "changed")
+				},
+				Config: fmt.Sprintf(`
+					resource "git_reset" "test" {
+						directory = "%s"
+						mode      = "hard"
+						force     = true
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testutils.CheckFileContent(worktree, name, "hello world!"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitReset_BareRepository(t *testing.T) {
+	t.Parallel()
+	directory := testutils.CreateBareRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_reset" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot reset bare repository`),
+			},
+		},
+	})
+}
+
+func TestResourceGitReset_Mode_Invalid(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_reset" "test" {
+						directory = "%s"
+						mode      = "keep"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
@@ -0,0 +1,209 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type RevertResource struct {
+	defaults *GitProviderModel
+}
+
+var (
+	_ resource.Resource              = (*RevertResource)(nil)
+	_ resource.ResourceWithConfigure = (*RevertResource)(nil)
+)
+
+type revertResourceModel struct {
+	Directory types.String `tfsdk:"directory"`
+	Id        types.Int64  `tfsdk:"id"`
+	Revisions types.List   `tfsdk:"revisions"`
+	Author    types.Object `tfsdk:"author"`
+	Committer types.Object `tfsdk:"committer"`
+	Signing   types.Object `tfsdk:"signing"`
+	SHA1      types.List   `tfsdk:"sha1"`
+}
+
+func NewRevertResource() resource.Resource {
+	return &RevertResource{}
+}
+
+func (r *RevertResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_revert"
+}
+
+func (r *RevertResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.defaults = providerDefaults(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *RevertResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	signing := signingResourceAttribute()
+	signing.Description = "The private key used to sign the created commits. If none is specified, the commits will not be signed."
+	signing.MarkdownDescription = "The private key used to sign the created commits. If none is specified, the commits will not be signed."
+
+	resp.Schema = schema.Schema{
+		Description: "Create commits which undo the changes introduced by existing commits similar to 'git revert'. Nothing is committed in case any of the commits conflicts with the current branch or its changes are already undone on the current branch. Note that configuration changes to this resource which cause a replacement will revert the commits again and keep the previously created commits as-is.",
+		MarkdownDescription: "Create commits which undo the changes introduced by existing commits similar to `git revert`. Nothing is committed in case any of the commits conflicts with the current branch or its changes are already undone on the current branch.\n\n" +
+			"-> **Note** Configuration changes to this resource which cause a replacement will revert the commits again and keep the previously created commits as-is.",
+		Attributes: map[string]schema.Attribute{
+			"directory": schema.StringAttribute{
+				Description:         "The path to the local Git repository.",
+				MarkdownDescription: "The path to the local Git repository.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.Int64Attribute{
+				Description:         "The timestamp of the last revert in Unix nanoseconds.",
+				MarkdownDescription: "The timestamp of the last revert in Unix nanoseconds.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"revisions": schema.ListAttribute{
+				Description:         "The revisions of the commits to revert in order. Note that 'go-git' does not support every revision type at the moment.",
+				MarkdownDescription: "The [revisions](https://www.git-scm.com/docs/gitrevisions) of the commits to revert in order. Note that `go-git` does not [support](https://pkg.go.dev/github.com/go-git/go-git/v5#Repository.ResolveRevision) every revision type at the moment.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"author":    authorResourceAttribute(),
+			"committer": committerResourceAttribute(),
+			"signing":   signing,
+			"sha1": schema.ListAttribute{
+				Description:         "The SHA1 hashes of the created commits in order.",
+				MarkdownDescription: "The SHA1 hashes of the created commits in order.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RevertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create resource git_revert")
+
+	var inputs revertResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := inputs.Directory.ValueString()
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	worktree, err := getWorktree(repository, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+	if worktree == nil {
+		resp.Diagnostics.AddError(
+			"Cannot revert in bare repository",
+			"The repository at ["+directory+"] is bare. Create a worktree first in order to revert commits.",
+		)
+		return
+	}
+
+	status := getStatus(ctx, worktree, &resp.Diagnostics)
+	if status == nil {
+		return
+	}
+	if hasLocalChanges(status) {
+		resp.Diagnostics.AddError(
+			"Cannot revert with local changes",
+			"The index or the worktree of repository ["+directory+"] contain uncommitted changes. Commit or reset them first in order to revert commits.",
+		)
+		return
+	}
+
+	picks := getCommitPicks(ctx, repository, inputs.Revisions, true, &resp.Diagnostics)
+	if picks == nil {
+		return
+	}
+
+	options := createCommitOptions(ctx, commitResourceModel{
+		Author:    inputs.Author,
+		Committer: inputs.Committer,
+		Signing:   inputs.Signing,
+	}, r.defaults, &resp.Diagnostics)
+	if options == nil {
+		return
+	}
+
+	hashes := pickCommits(ctx, repository, worktree, picks, options, "revert", &resp.Diagnostics)
+	if hashes == nil {
+		return
+	}
+
+	var state revertResourceModel
+	state.Directory = inputs.Directory
+	state.Id = types.Int64Value(time.Now().UnixNano())
+	state.Revisions = inputs.Revisions
+	state.Author = signatureObject(&inputs.Author)
+	state.Committer = signatureObject(&inputs.Committer)
+	state.Signing = inputs.Signing
+	state.SHA1, diags = types.ListValueFrom(ctx, types.StringType, hashes)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "reverted commits", map[string]interface{}{
+		"directory": directory,
+		"sha1":      hashes,
+	})
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *RevertResource) Read(ctx context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+	tflog.Debug(ctx, "Read resource git_revert")
+	// NO-OP: All data is already in Terraform state
+}
+
+func (r *RevertResource) Update(ctx context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Update resource git_revert")
+	// NO-OP: All attributes require replacement, thus delete/create will be called
+}
+
+func (r *RevertResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Delete resource git_revert")
+	// NO-OP: Terraform removes the state automatically for us
+}
@@ -0,0 +1,246 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/metio/terraform-provider-git/internal/testutils"
+)
+
+func TestResourceGitRevert(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	cfg := testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	head := testutils.GetRepositoryHead(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_revert" "test" {
+						directory = "%s"
+						revisions = ["HEAD"]
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_revert.test", "directory", directory),
+					resource.TestCheckResourceAttrWith("git_revert.test", "id", testutils.CheckMinLength(1)),
+					resource.TestCheckResourceAttr("git_revert.test", "revisions.#", "1"),
+					resource.TestCheckResourceAttr("git_revert.test", "revisions.0", "HEAD"),
+					resource.TestCheckResourceAttr("git_revert.test", "sha1.#", "1"),
+					resource.TestCheckResourceAttrWith("git_revert.test", "sha1.0", func(value string) error {
+						commit, err := repository.CommitObject(plumbing.NewHash(value))
+						if err != nil {
+							return err
+						}
+						expected := "Revert \"example go-git commit\"\n\nThis reverts commit " + head.Hash().String() + ".\n"
+						if commit.Message != expected {
+							return fmt.Errorf("unexpected commit message %q", commit.Message)
+						}
+						if commit.Author.Name != cfg.Author.Name {
+							return fmt.Errorf("expected author %q, got %q", cfg.Author.Name, commit.Author.Name)
+						}
+						return nil
+					}),
+					testutils.CheckFileContent(worktree, "some-file", "hello world!"),
+					func(_ *terraform.State) error {
+						if _, err := worktree.Filesystem.Stat("other-file"); err == nil {
+							return fmt.Errorf("expected 'other-file' to be removed")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitRevert_Multiple(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	name := "some-file"
+	testutils.AddAndCommitNewFile(t, worktree, name)
+	testutils.WriteFileContent(t, testutils.FileInWorktree(worktree, name), "first")
+	testutils.GitAdd(t, worktree, name)
+	testutils.GitCommit(t, worktree)
+	testutils.WriteFileContent(t, testutils.FileInWorktree(worktree, name), "second")
+	testutils.GitAdd(t, worktree, name)
+	testutils.GitCommit(t, worktree)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_revert" "test" {
+						directory = "%s"
+						revisions = ["HEAD", "HEAD~1"]
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_revert.test", "sha1.#", "2"),
+					testutils.CheckFileContent(worktree, name, "hello world!"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitRevert_Author(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_revert" "test" {
+						directory = "%s"
+						revisions = ["HEAD"]
+						author = {
+							name  = "terraform"
+							email = "automation@example.com"
+						}
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_revert.test", "author.name", "terraform"),
+					resource.TestCheckResourceAttr("git_revert.test", "author.email", "automation@example.com"),
+					resource.TestCheckResourceAttrWith("git_revert.test", "sha1.0", func(value string) error {
+						commit, err := repository.CommitObject(plumbing.NewHash(value))
+						if err != nil {
+							return err
+						}
+						if commit.Author.Name != "terraform" || commit.Author.Email != "automation@example.com" {
+							return fmt.Errorf("unexpected author %q", commit.Author.String())
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitRevert_Conflict(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	name := "some-file"
+	testutils.AddAndCommitNewFile(t, worktree, name)
+	testutils.WriteFileContent(t, testutils.FileInWorktree(worktree, name), "first")
+	testutils.GitAdd(t, worktree, name)
+	testutils.GitCommit(t, worktree)
+	testutils.WriteFileContent(t, testutils.FileInWorktree(worktree, name), "second")
+	testutils.GitAdd(t, worktree, name)
+	testutils.GitCommit(t, worktree)
+	head := testutils.GetRepositoryHead(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_revert" "test" {
+						directory = "%s"
+						revisions = ["HEAD~1"]
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot revert conflicting changes`),
+			},
+		},
+	})
+
+	if current := testutils.GetRepositoryHead(t, repository); current.Hash() != head.Hash() {
+		t.Errorf("expected HEAD to stay at %s, got %s", head.Hash(), current.Hash())
+	}
+}
+
+func TestResourceGitRevert_AlreadyReverted(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	head := testutils.GetRepositoryHead(t, repository)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_revert" "test" {
+						directory = "%s"
+						revisions = ["HEAD", "HEAD"]
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot revert empty commit`),
+			},
+		},
+	})
+
+	if current := testutils.GetRepositoryHead(t, repository); current.Hash() != head.Hash() {
+		t.Errorf("expected HEAD to stay at %s, got %s", head.Hash(), current.Hash())
+	}
+}
+
+func TestResourceGitRevert_BareRepository(t *testing.T) {
+	t.Parallel()
+	directory := testutils.CreateBareRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_revert" "test" {
+						directory = "%s"
+						revisions = ["HEAD"]
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot revert in bare repository`),
+			},
+		},
+	})
+}
+
+func TestResourceGitRevert_Revisions_Missing(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_revert" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Missing required argument`),
+			},
+		},
+	})
+}
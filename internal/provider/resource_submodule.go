@@ -0,0 +1,369 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/metio/terraform-provider-git/internal/modifiers"
+)
+
+type SubmoduleResource struct {
+	defaults *GitProviderModel
+}
+
+var (
+	_ resource.Resource               = (*SubmoduleResource)(nil)
+	_ resource.ResourceWithModifyPlan = (*SubmoduleResource)(nil)
+	_ resource.ResourceWithConfigure  = (*SubmoduleResource)(nil)
+)
+
+type SubmoduleResourceModel struct {
+	Directory  types.String `tfsdk:"directory"`
+	Id         types.Int64  `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Init       types.Bool   `tfsdk:"init"`
+	NoFetch    types.Bool   `tfsdk:"no_fetch"`
+	Recursive  types.Bool   `tfsdk:"recursive"`
+	Depth      types.Int64  `tfsdk:"depth"`
+	Auth       types.Object `tfsdk:"auth"`
+	Submodules types.Map    `tfsdk:"submodules"`
+}
+
+func NewSubmoduleResource() resource.Resource {
+	return &SubmoduleResource{}
+}
+
+func (r *SubmoduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_submodule"
+}
+
+func (r *SubmoduleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.defaults = providerDefaults(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *SubmoduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Initializes and updates submodules of a Git repository similar to 'git submodule update --init'.",
+		MarkdownDescription: "Initializes and updates submodules of a Git repository similar to `git submodule update --init`.",
+		Attributes: map[string]schema.Attribute{
+			"directory": schema.StringAttribute{
+				Description:         "The path to the local Git repository.",
+				MarkdownDescription: "The path to the local Git repository.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.Int64Attribute{
+				Description:         "The timestamp of the last submodule update in Unix nanoseconds.",
+				MarkdownDescription: "The timestamp of the last submodule update in Unix nanoseconds.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				Description:         "The name of the submodule to update as declared in '.gitmodules'. All submodules are updated if none is specified.",
+				MarkdownDescription: "The name of the submodule to update as declared in `.gitmodules`. All submodules are updated if none is specified.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"init": schema.BoolAttribute{
+				Description:         "Initialize submodules that have not been initialized yet. Defaults to 'true'.",
+				MarkdownDescription: "Initialize submodules that have not been initialized yet. Defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(true),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"no_fetch": schema.BoolAttribute{
+				Description:         "Do not fetch new objects from the remote of each submodule. Defaults to 'false'.",
+				MarkdownDescription: "Do not fetch new objects from the remote of each submodule. Defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"recursive": schema.BoolAttribute{
+				Description:         "Update nested submodules inside the submodules as well. Defaults to 'false'.",
+				MarkdownDescription: "Update nested submodules inside the submodules as well. Defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"depth": schema.Int64Attribute{
+				Description:         "Limit fetching to the specified number of commits. Defaults to '0' which fetches the entire history.",
+				MarkdownDescription: "Limit fetching to the specified number of commits. Defaults to `0` which fetches the entire history.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					modifiers.DefaultInt64(0),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"auth": authResourceAttribute(),
+			"submodules": schema.MapAttribute{
+				Description:         "The SHA1 hash of the commit checked out in each updated submodule keyed by the name of the submodule.",
+				MarkdownDescription: "The SHA1 hash of the commit checked out in each updated submodule keyed by the name of the submodule.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SubmoduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create resource git_submodule")
+
+	var inputs SubmoduleResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := inputs.Directory.ValueString()
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	worktree := submoduleWorktree(repository, directory, &resp.Diagnostics)
+	if worktree == nil {
+		return
+	}
+
+	submodules := getSubmodules(ctx, worktree, inputs.Name, &resp.Diagnostics)
+	if submodules == nil {
+		return
+	}
+
+	options := CreateSubmoduleUpdateOptions(ctx, &inputs, r.defaults, &resp.Diagnostics)
+	if options == nil {
+		return
+	}
+
+	for _, submodule := range submodules {
+		err := submodule.UpdateContext(ctx, options)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Cannot update submodule",
+				"Could not update submodule ["+submodule.Config().Name+"] in repository ["+directory+"] because of: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "updated submodules", map[string]interface{}{
+		"directory":  directory,
+		"submodules": len(submodules),
+	})
+
+	// re-read submodules since their initialization state changed during the update
+	submodules = getSubmodules(ctx, worktree, inputs.Name, &resp.Diagnostics)
+	if submodules == nil {
+		return
+	}
+
+	var state SubmoduleResourceModel
+	state.Directory = inputs.Directory
+	state.Id = types.Int64Value(time.Now().UnixNano())
+	state.Name = inputs.Name
+	state.Init = inputs.Init
+	state.NoFetch = inputs.NoFetch
+	state.Recursive = inputs.Recursive
+	state.Depth = inputs.Depth
+	state.Auth = inputs.Auth
+	state.Submodules = checkedOutSubmodules(ctx, submodules, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SubmoduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Read resource git_submodule")
+
+	var state SubmoduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := state.Directory.ValueString()
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	worktree := submoduleWorktree(repository, directory, &resp.Diagnostics)
+	if worktree == nil {
+		return
+	}
+
+	submodules := getSubmodules(ctx, worktree, state.Name, &resp.Diagnostics)
+	if submodules == nil {
+		return
+	}
+
+	state.Submodules = checkedOutSubmodules(ctx, submodules, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SubmoduleResource) Update(ctx context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Update resource git_submodule")
+	// NO-OP: All attributes require replacement, thus delete/create will be called
+}
+
+func (r *SubmoduleResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Delete resource git_submodule")
+	// NO-OP: Terraform removes the state automatically for us
+}
+
+func (r *SubmoduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	tflog.Debug(ctx, "ModifyPlan resource git_submodule")
+
+	if req.State.Raw.IsNull() {
+		// if we're creating the resource, no need to modify it
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		// if we're deleting the resource, no need to modify it
+		return
+	}
+
+	var inputs SubmoduleResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repository := openRepository(ctx, inputs.Directory.ValueString(), &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	worktree, err := getWorktree(repository, &resp.Diagnostics)
+	if err != nil || worktree == nil {
+		return
+	}
+
+	submodules := getSubmodules(ctx, worktree, inputs.Name, &resp.Diagnostics)
+	if submodules == nil {
+		return
+	}
+
+	statuses := getSubmoduleStatuses(ctx, submodules, &resp.Diagnostics)
+	if statuses == nil {
+		return
+	}
+
+	for name, status := range statuses {
+		if status.Current.IsZero() && !inputs.Init.ValueBool() {
+			// submodules that are not initialized are only touched with 'init'
+			continue
+		}
+		if !status.IsClean() {
+			tflog.Trace(ctx, "submodule differs from index", map[string]interface{}{
+				"submodule": name,
+				"current":   status.Current.String(),
+				"expected":  status.Expected.String(),
+			})
+			submodulesPath := path.Root("submodules")
+			resp.Plan.SetAttribute(ctx, submodulesPath, types.MapUnknown(types.StringType))
+			resp.RequiresReplace = append(resp.RequiresReplace, submodulesPath)
+			return
+		}
+	}
+}
+
+func submoduleWorktree(repository *git.Repository, directory string, diag *diag.Diagnostics) *git.Worktree {
+	worktree, err := getWorktree(repository, diag)
+	if err != nil {
+		return nil
+	}
+	if worktree == nil {
+		diag.AddError(
+			"Cannot update submodules in bare repository",
+			"The repository at ["+directory+"] is bare. Create a worktree first in order to update submodules.",
+		)
+		return nil
+	}
+	return worktree
+}
+
+// checkedOutSubmodules maps the name of each initialized submodule to the hash of its checked out commit.
+func checkedOutSubmodules(ctx context.Context, submodules git.Submodules, diag *diag.Diagnostics) types.Map {
+	statuses := getSubmoduleStatuses(ctx, submodules, diag)
+	if diag.HasError() {
+		return types.MapNull(types.StringType)
+	}
+
+	hashes := make(map[string]string)
+	for name, status := range statuses {
+		if !status.Current.IsZero() {
+			hashes[name] = status.Current.String()
+		}
+	}
+
+	value, diags := types.MapValueFrom(ctx, types.StringType, hashes)
+	diag.Append(diags...)
+	return value
+}
@@ -0,0 +1,170 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/metio/terraform-provider-git/internal/testutils"
+)
+
+func TestResourceGitSubmodule(t *testing.T) {
+	t.Parallel()
+	submoduleDirectory, submoduleRepository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, submoduleRepository)
+	submoduleWorktree := testutils.GetRepositoryWorktree(t, submoduleRepository)
+	testutils.AddAndCommitNewFile(t, submoduleWorktree, "some-file")
+	submoduleHead := testutils.GetRepositoryHead(t, submoduleRepository)
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	testutils.AddSubmodule(t, repository, "library", "vendor/library", submoduleDirectory, submoduleHead.Hash())
+	testutils.GitCommit(t, testutils.GetRepositoryWorktree(t, repository))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_submodule" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_submodule.test", "directory", directory),
+					resource.TestCheckResourceAttrWith("git_submodule.test", "id", testutils.CheckMinLength(1)),
+					resource.TestCheckNoResourceAttr("git_submodule.test", "name"),
+					resource.TestCheckResourceAttr("git_submodule.test", "init", "true"),
+					resource.TestCheckResourceAttr("git_submodule.test", "no_fetch", "false"),
+					resource.TestCheckResourceAttr("git_submodule.test", "recursive", "false"),
+					resource.TestCheckResourceAttr("git_submodule.test", "depth", "0"),
+					resource.TestCheckResourceAttr("git_submodule.test", "submodules.%", "1"),
+					resource.TestCheckResourceAttr("git_submodule.test", "submodules.library", submoduleHead.Hash().String()),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitSubmodule_Name(t *testing.T) {
+	t.Parallel()
+	submoduleDirectory, submoduleRepository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, submoduleRepository)
+	submoduleWorktree := testutils.GetRepositoryWorktree(t, submoduleRepository)
+	testutils.AddAndCommitNewFile(t, submoduleWorktree, "some-file")
+	submoduleHead := testutils.GetRepositoryHead(t, submoduleRepository)
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	testutils.AddSubmodule(t, repository, "first", "vendor/first", submoduleDirectory, submoduleHead.Hash())
+	testutils.AddSubmodule(t, repository, "second", "vendor/second", submoduleDirectory, submoduleHead.Hash())
+	testutils.GitCommit(t, testutils.GetRepositoryWorktree(t, repository))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_submodule" "test" {
+						directory = "%s"
+						name      = "second"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_submodule.test", "name", "second"),
+					resource.TestCheckResourceAttr("git_submodule.test", "submodules.%", "1"),
+					resource.TestCheckResourceAttr("git_submodule.test", "submodules.second", submoduleHead.Hash().String()),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitSubmodule_Drift(t *testing.T) {
+	t.Parallel()
+	submoduleDirectory, submoduleRepository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, submoduleRepository)
+	submoduleWorktree := testutils.GetRepositoryWorktree(t, submoduleRepository)
+	testutils.AddAndCommitNewFile(t, submoduleWorktree, "some-file")
+	first := testutils.GetRepositoryHead(t, submoduleRepository)
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddSubmodule(t, repository, "library", "vendor/library", submoduleDirectory, first.Hash())
+	testutils.GitCommit(t, worktree)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_submodule" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_submodule.test", "submodules.library", first.Hash().String()),
+				),
+			},
+			{
+				PreConfig: func() {
+					testutils.AddAndCommitNewFile(t, submoduleWorktree, "other-file")
+					second := testutils.GetRepositoryHead(t, submoduleRepository)
+					testutils.SetSubmoduleHash(t, repository, "vendor/library", second.Hash())
+					testutils.GitCommit(t, worktree)
+				},
+				Config: fmt.Sprintf(`
+					resource "git_submodule" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("git_submodule.test", "submodules.library", testutils.CheckExactLength(40)),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitSubmodule_Unknown(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_submodule" "test" {
+						directory = "%s"
+						name      = "does-not-exist"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot find submodule`),
+			},
+		},
+	})
+}
+
+func TestResourceGitSubmodule_BareRepository(t *testing.T) {
+	t.Parallel()
+	directory := testutils.CreateBareRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_submodule" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Cannot update submodules in bare repository`),
+			},
+		},
+	})
+}
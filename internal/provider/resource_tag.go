@@ -10,9 +10,13 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -32,6 +36,8 @@ type tagResourceModel struct {
 	Id        types.String `tfsdk:"id"`
 	Name      types.String `tfsdk:"name"`
 	Message   types.String `tfsdk:"message"`
+	Tagger    types.Object `tfsdk:"tagger"`
+	Signing   types.Object `tfsdk:"signing"`
 	Revision  types.String `tfsdk:"revision"`
 	SHA1      types.String `tfsdk:"sha1"`
 }
@@ -45,6 +51,13 @@ func (r *TagResource) Metadata(_ context.Context, req resource.MetadataRequest,
 }
 
 func (r *TagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	signing := signingResourceAttribute()
+	signing.Description = "The private key used to sign the created tag. Requires 'message' to be set. If none is specified, the tag will not be signed."
+	signing.MarkdownDescription = "The private key used to sign the created tag. Requires `message` to be set. If none is specified, the tag will not be signed."
+	signing.Validators = []validator.Object{
+		objectvalidator.AlsoRequires(path.MatchRoot("message")),
+	}
+
 	resp.Schema = schema.Schema{
 		Description:         "Manage Git tags similar to 'git tag'.",
 		MarkdownDescription: "Manage Git tags similar to `git tag`.",
@@ -99,6 +112,36 @@ func (r *TagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"tagger": schema.SingleNestedAttribute{
+				Description:         "The person creating an annotated tag. Requires 'message' to be set. If none is specified, the tagger will be read from the Git configuration.",
+				MarkdownDescription: "The person creating an annotated tag. Requires `message` to be set. If none is specified, the tagger will be read from the Git configuration.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description:         "The name of the tagger.",
+						MarkdownDescription: "The name of the tagger.",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"email": schema.StringAttribute{
+						Description:         "The email address of the tagger.",
+						MarkdownDescription: "The email address of the tagger.",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(path.MatchRoot("message")),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"signing": signing,
 		},
 	}
 }
@@ -126,12 +169,7 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
-	_, err := repository.CreateTag(tagName, *hash, createTagOptions(inputs))
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Cannot create tag",
-			"Could not create tag ["+tagName+"] in git repository ["+directory+"] because of: "+err.Error(),
-		)
+	if createTag(ctx, repository, tagName, *hash, inputs, &resp.Diagnostics) == nil {
 		return
 	}
 
@@ -145,6 +183,8 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 	state.Id = types.StringValue(fmt.Sprintf("%s|%s", directory, tagName))
 	state.Name = inputs.Name
 	state.Message = inputs.Message
+	state.Tagger = inputs.Tagger
+	state.Signing = inputs.Signing
 	state.Revision = inputs.Revision
 	state.SHA1 = types.StringValue(hash.String())
 
@@ -190,11 +230,18 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	newState.Id = types.StringValue(fmt.Sprintf("%s|%s", directory, tagName))
 	newState.Name = state.Name
 	newState.Revision = state.Revision
+	newState.Signing = state.Signing
 	newState.SHA1 = types.StringValue(tagReference.Hash().String())
 	if tagObject == nil {
 		newState.Message = types.StringNull()
+		newState.Tagger = state.Tagger
 	} else {
 		newState.Message = types.StringValue(strings.TrimSpace(tagObject.Message))
+		if state.Tagger.IsNull() {
+			newState.Tagger = state.Tagger
+		} else {
+			newState.Tagger = signatureToObjectWithoutTimestamp(&tagObject.Tagger)
+		}
 	}
 
 	diags = resp.State.Set(ctx, &newState)
@@ -278,6 +325,16 @@ func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateR
 	state.Name = types.StringValue(tagName)
 	state.Revision = types.StringValue(revision)
 	state.SHA1 = types.StringValue(tagReference.Hash().String())
+	state.Tagger = types.ObjectNull(map[string]attr.Type{
+		"name":  types.StringType,
+		"email": types.StringType,
+	})
+	state.Signing = types.ObjectNull(map[string]attr.Type{
+		"format":           types.StringType,
+		"private_key_pem":  types.StringType,
+		"private_key_path": types.StringType,
+		"passphrase":       types.StringType,
+	})
 	if tagObject == nil {
 		state.Message = types.StringNull()
 	} else {
@@ -76,6 +76,178 @@ func TestResourceGitTag_Annotated(t *testing.T) {
 	})
 }
 
+func TestResourceGitTag_Tagger(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	name := "some-name"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_tag" "test" {
+						directory = "%s"
+						name      = "%s"
+						message   = "some message for the tag"
+						tagger    = {
+							name  = "Some Tagger"
+							email = "tagger@example.com"
+						}
+					}
+
+					data "git_tag" "test" {
+						directory = git_tag.test.directory
+						name      = git_tag.test.name
+					}
+				`, directory, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_tag.test", "tagger.name", "Some Tagger"),
+					resource.TestCheckResourceAttr("git_tag.test", "tagger.email", "tagger@example.com"),
+					resource.TestCheckResourceAttr("data.git_tag.test", "tagger.name", "Some Tagger"),
+					resource.TestCheckResourceAttr("data.git_tag.test", "tagger.email", "tagger@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitTag_Tagger_WithoutMessage(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_tag" "test" {
+						directory = "%s"
+						name      = "some-name"
+						tagger    = {
+							name  = "Some Tagger"
+							email = "tagger@example.com"
+						}
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestResourceGitTag_Signing_OpenPGP(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	_, privateKey, publicKey := testutils.OpenPGPKey(t, "secret")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_tag" "test" {
+						directory = "%s"
+						name      = "v1.0.0"
+						message   = "signed with terraform"
+						signing   = {
+							private_key_pem = <<EOT
+%sEOT
+							passphrase      = "secret"
+						}
+					}
+
+					data "git_tag" "test" {
+						directory = git_tag.test.directory
+						name      = git_tag.test.name
+						keyring   = <<EOT
+%sEOT
+					}
+				`, directory, privateKey, publicKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("git_tag.test", "sha1", testutils.CheckExactLength(40)),
+					resource.TestCheckResourceAttrWith("data.git_tag.test", "signature", testutils.CheckMinLength(1)),
+					resource.TestCheckResourceAttr("data.git_tag.test", "verified", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitTag_Signing_SSH(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	testutils.TestConfig(t, repository)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	privateKey, _ := testutils.SSHKey(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_tag" "test" {
+						directory = "%s"
+						name      = "v1.0.0"
+						message   = "signed with terraform"
+						signing   = {
+							format          = "ssh"
+							private_key_pem = <<EOT
+%sEOT
+						}
+					}
+
+					data "git_tag" "test" {
+						directory = git_tag.test.directory
+						name      = git_tag.test.name
+					}
+				`, directory, privateKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("git_tag.test", "sha1", testutils.CheckExactLength(40)),
+					resource.TestCheckResourceAttr("data.git_tag.test", "message", "signed with terraform\n"),
+					resource.TestMatchResourceAttr("data.git_tag.test", "signature", regexp.MustCompile(`^-----BEGIN SSH SIGNATURE-----`)),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitTag_Signing_WithoutMessage(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	privateKey, _ := testutils.SSHKey(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_tag" "test" {
+						directory = "%s"
+						name      = "v1.0.0"
+						signing   = {
+							format          = "ssh"
+							private_key_pem = <<EOT
+%sEOT
+						}
+					}
+				`, directory, privateKey),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
 func TestResourceGitTag_Revision_Hash(t *testing.T) {
 	t.Parallel()
 	directory, repository := testutils.CreateRepository(t)
@@ -0,0 +1,427 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/metio/terraform-provider-git/internal/modifiers"
+)
+
+type WorktreeResource struct{}
+
+var (
+	_ resource.Resource = (*WorktreeResource)(nil)
+)
+
+type worktreeResourceModel struct {
+	Directory  types.String `tfsdk:"directory"`
+	Id         types.String `tfsdk:"id"`
+	Path       types.String `tfsdk:"path"`
+	Name       types.String `tfsdk:"name"`
+	Branch     types.String `tfsdk:"branch"`
+	Revision   types.String `tfsdk:"revision"`
+	Create     types.Bool   `tfsdk:"create"`
+	Locked     types.Bool   `tfsdk:"locked"`
+	LockReason types.String `tfsdk:"lock_reason"`
+	Force      types.Bool   `tfsdk:"force"`
+	SHA1       types.String `tfsdk:"sha1"`
+}
+
+func NewWorktreeResource() resource.Resource {
+	return &WorktreeResource{}
+}
+
+func (r *WorktreeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_worktree"
+}
+
+func (r *WorktreeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Creates a linked worktree for a branch or commit similar to 'git worktree add'. The worktree is removed on destroy similar to 'git worktree remove'. Directories which no longer belong to the worktree are kept.",
+		MarkdownDescription: "Creates a linked worktree for a branch or commit similar to `git worktree add`. The worktree is removed on destroy similar to `git worktree remove`. Directories which no longer belong to the worktree are kept.",
+		Attributes: map[string]schema.Attribute{
+			"directory": schema.StringAttribute{
+				Description:         "The path to the local Git repository.",
+				MarkdownDescription: "The path to the local Git repository.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description:         "The same value as the 'path' attribute.",
+				MarkdownDescription: "The same value as the `path` attribute.",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				Description:         "The path to the new worktree. The directory must either not exist or be empty.",
+				MarkdownDescription: "The path to the new worktree. The directory must either not exist or be empty.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "The name of the administrative directory of the worktree below '$GIT_DIR/worktrees'.",
+				MarkdownDescription: "The name of the administrative directory of the worktree below `$GIT_DIR/worktrees`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"branch": schema.StringAttribute{
+				Description:         "The branch to check out in the worktree, e.g. 'main' or 'refs/heads/main'. A branch can only be checked out in one worktree at a time unless 'force' is set. Conflicts with 'revision' unless 'create' is set. If neither 'branch' nor 'revision' is specified, the current 'HEAD' is checked out in detached mode.",
+				MarkdownDescription: "The branch to check out in the worktree, e.g. `main` or `refs/heads/main`. A branch can only be checked out in one worktree at a time unless `force` is set. Conflicts with `revision` unless `create` is set. If neither `branch` nor `revision` is specified, the current `HEAD` is checked out in detached mode.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"revision": schema.StringAttribute{
+				Description:         "The revision to check out in detached mode. Note that 'go-git' does not support every revision type at the moment. In case 'create' is set, the new branch will start at this revision. Defaults to 'HEAD'.",
+				MarkdownDescription: "The [revision](https://www.git-scm.com/docs/gitrevisions) to check out in detached mode. Note that `go-git` does not [support](https://pkg.go.dev/github.com/go-git/go-git/v5#Repository.ResolveRevision) every revision type at the moment. In case `create` is set, the new branch will start at this revision. Defaults to `HEAD`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"create": schema.BoolAttribute{
+				Description:         "Create the branch before checking it out. Defaults to 'false'.",
+				MarkdownDescription: "Create the branch before checking it out. Defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"locked": schema.BoolAttribute{
+				Description:         "Lock the worktree to prevent it from being pruned, moved, or removed similar to 'git worktree lock'. Defaults to 'false'.",
+				MarkdownDescription: "Lock the worktree to prevent it from being pruned, moved, or removed similar to `git worktree lock`. Defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+				},
+			},
+			"lock_reason": schema.StringAttribute{
+				Description:         "The reason why the worktree is locked. Only used in case 'locked' is set.",
+				MarkdownDescription: "The reason why the worktree is locked. Only used in case `locked` is set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"force": schema.BoolAttribute{
+				Description:         "Check out a branch even if it is already checked out in another worktree, and remove the worktree on destroy even if it is locked or contains local changes. Defaults to 'false'.",
+				MarkdownDescription: "Check out a branch even if it is already checked out in another worktree, and remove the worktree on destroy even if it is locked or contains local changes. Defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+				},
+			},
+			"sha1": schema.StringAttribute{
+				Description:         "The SHA1 hash of the commit checked out in the worktree.",
+				MarkdownDescription: "The SHA1 hash of the commit checked out in the worktree.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *WorktreeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create resource git_worktree")
+
+	var inputs worktreeResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory := inputs.Directory.ValueString()
+	worktreePath := inputs.Path.ValueString()
+
+	if !inputs.Branch.IsNull() && !inputs.Revision.IsNull() && !inputs.Create.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Invalid worktree options",
+			"The 'branch' and 'revision' options can only be used together in case 'create' is set to 'true'.",
+		)
+		return
+	}
+	if inputs.Branch.IsNull() && inputs.Create.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Invalid worktree options",
+			"The 'create' option requires a 'branch' to create.",
+		)
+		return
+	}
+
+	repository := openRepository(ctx, directory, &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	var branch plumbing.ReferenceName
+	var hash *plumbing.Hash
+	if !inputs.Branch.IsNull() {
+		branch = expandBranchReferenceName(inputs.Branch.ValueString())
+	}
+	if !inputs.Branch.IsNull() && !inputs.Create.ValueBool() {
+		reference, err := getBranchReference(ctx, repository, branch.Short(), &resp.Diagnostics)
+		if err != nil {
+			return
+		}
+		if reference == nil {
+			resp.Diagnostics.AddError(
+				"Cannot create worktree",
+				"The branch ["+branch.Short()+"] does not exist in ["+directory+"]. Set 'create' to 'true' in order to create it.",
+			)
+			return
+		}
+		resolved := reference.Hash()
+		hash = &resolved
+	} else {
+		revision := "HEAD"
+		if !inputs.Revision.IsNull() {
+			revision = inputs.Revision.ValueString()
+		}
+		hash = resolveRevision(ctx, repository, revision, &resp.Diagnostics)
+		if hash == nil {
+			return
+		}
+	}
+
+	if branch != "" && !inputs.Force.ValueBool() {
+		worktrees := listWorktrees(ctx, repository, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if existing := findWorktree(worktrees, branch); existing != nil {
+			resp.Diagnostics.AddError(
+				"Cannot create worktree",
+				"The branch ["+branch.Short()+"] is already checked out in worktree ["+existing.path+"]. Set 'force' to 'true' in order to check it out anyway.",
+			)
+			return
+		}
+	}
+
+	if inputs.Create.ValueBool() {
+		setBranchReference(ctx, repository, branch.Short(), *hash, inputs.Force.ValueBool(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	name := addWorktree(ctx, repository, worktreePath, branch, *hash, &resp.Diagnostics)
+	if name == "" {
+		return
+	}
+
+	if inputs.Locked.ValueBool() {
+		common := commonDirectory(repository, &resp.Diagnostics)
+		if common == "" {
+			return
+		}
+		lockWorktree(ctx, common, name, true, inputs.LockReason.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var state worktreeResourceModel
+	state.Directory = inputs.Directory
+	state.Id = inputs.Path
+	state.Path = inputs.Path
+	state.Name = types.StringValue(name)
+	state.Branch = inputs.Branch
+	state.Revision = inputs.Revision
+	state.Create = inputs.Create
+	state.Locked = inputs.Locked
+	state.LockReason = inputs.LockReason
+	state.Force = inputs.Force
+	state.SHA1 = types.StringValue(hash.String())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *WorktreeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Read resource git_worktree")
+
+	var state worktreeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repository := openRepository(ctx, state.Directory.ValueString(), &resp.Diagnostics)
+	if repository == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	existing := findWorktreeByName(ctx, repository, state.Name.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if existing == nil || existing.prunable {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var newState worktreeResourceModel
+	newState.Directory = state.Directory
+	newState.Id = state.Path
+	newState.Path = state.Path
+	newState.Name = state.Name
+	newState.Branch = state.Branch
+	newState.Revision = state.Revision
+	newState.Create = state.Create
+	newState.Locked = types.BoolValue(existing.locked)
+	newState.LockReason = state.LockReason
+	if existing.locked && existing.lockReason != "" {
+		newState.LockReason = types.StringValue(existing.lockReason)
+	}
+	newState.Force = state.Force
+	newState.SHA1 = state.SHA1
+	if hash := resolveWorktreeHead(repository, existing.head); hash != nil {
+		newState.SHA1 = types.StringValue(hash.String())
+	}
+
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *WorktreeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Update resource git_worktree")
+
+	var inputs worktreeResourceModel
+	diags := req.Plan.Get(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repository := openRepository(ctx, inputs.Directory.ValueString(), &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	common := commonDirectory(repository, &resp.Diagnostics)
+	if common == "" {
+		return
+	}
+
+	lockWorktree(ctx, common, inputs.Name.ValueString(), inputs.Locked.ValueBool(), inputs.LockReason.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &inputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *WorktreeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Delete resource git_worktree")
+
+	var state worktreeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	worktreePath := state.Path.ValueString()
+	name := state.Name.ValueString()
+
+	repository := openRepository(ctx, state.Directory.ValueString(), &resp.Diagnostics)
+	if repository == nil {
+		return
+	}
+
+	common := commonDirectory(repository, &resp.Diagnostics)
+	if common == "" {
+		return
+	}
+
+	existing := findWorktreeByName(ctx, repository, name, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if existing != nil && !existing.prunable && !state.Force.ValueBool() {
+		if existing.locked {
+			resp.Diagnostics.AddError(
+				"Cannot remove worktree",
+				"The worktree ["+worktreePath+"] is locked. Set 'locked' to 'false' or 'force' to 'true' in order to remove it.",
+			)
+			return
+		}
+		linked := openRepository(ctx, worktreePath, &resp.Diagnostics)
+		if linked == nil {
+			return
+		}
+		worktree, err := getWorktree(linked, &resp.Diagnostics)
+		if err != nil {
+			return
+		}
+		status := getStatus(ctx, worktree, &resp.Diagnostics)
+		if status == nil {
+			return
+		}
+		if !status.IsClean() {
+			resp.Diagnostics.AddError(
+				"Cannot remove worktree",
+				"The worktree ["+worktreePath+"] contains modified or untracked files. Set 'force' to 'true' in order to remove it anyway.",
+			)
+			return
+		}
+	}
+
+	if existing != nil && existing.path != "" {
+		worktreePath = existing.path
+	}
+	removeWorktree(ctx, common, name, filepath.Clean(worktreePath), &resp.Diagnostics)
+}
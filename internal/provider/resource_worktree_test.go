@@ -0,0 +1,485 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/metio/terraform-provider-git/internal/testutils"
+)
+
+func TestResourceGitWorktree(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	head := testutils.GetRepositoryHead(t, repository)
+	path := filepath.Join(t.TempDir(), "linked")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_worktree" "test" {
+						directory = "%s"
+						path      = "%s"
+					}
+				`, directory, path),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_worktree.test", "directory", directory),
+					resource.TestCheckResourceAttr("git_worktree.test", "id", path),
+					resource.TestCheckResourceAttr("git_worktree.test", "path", path),
+					resource.TestCheckResourceAttr("git_worktree.test", "name", "linked"),
+					resource.TestCheckNoResourceAttr("git_worktree.test", "branch"),
+					resource.TestCheckNoResourceAttr("git_worktree.test", "revision"),
+					resource.TestCheckResourceAttr("git_worktree.test", "create", "false"),
+					resource.TestCheckResourceAttr("git_worktree.test", "locked", "false"),
+					resource.TestCheckNoResourceAttr("git_worktree.test", "lock_reason"),
+					resource.TestCheckResourceAttr("git_worktree.test", "force", "false"),
+					resource.TestCheckResourceAttr("git_worktree.test", "sha1", head.Hash().String()),
+					func(_ *terraform.State) error {
+						content, err := os.ReadFile(filepath.Join(path, "some-file"))
+						if err != nil {
+							return err
+						}
+						if string(content) != "hello world!" {
+							return fmt.Errorf("unexpected content %q", content)
+						}
+						return nil
+					},
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if _, err := os.Stat(path); err == nil {
+				return fmt.Errorf("expected worktree [%s] to be removed", path)
+			}
+			if _, err := os.Stat(filepath.Join(directory, ".git", "worktrees", "linked")); err == nil {
+				return fmt.Errorf("expected administrative files of worktree to be removed")
+			}
+			return nil
+		},
+	})
+}
+
+func TestResourceGitWorktree_Branch(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.GitCheckoutBranch(t, worktree, "other", true)
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	other := testutils.GetRepositoryHead(t, repository)
+	testutils.GitCheckoutBranch(t, worktree, "master", false)
+	path := filepath.Join(t.TempDir(), "other")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_worktree" "test" {
+						directory = "%s"
+						path      = "%s"
+						branch    = "other"
+					}
+				`, directory, path),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_worktree.test", "branch", "other"),
+					resource.TestCheckResourceAttr("git_worktree.test", "sha1", other.Hash().String()),
+					func(_ *terraform.State) error {
+						head, err := os.ReadFile(filepath.Join(directory, ".git", "worktrees", "other", "HEAD"))
+						if err != nil {
+							return err
+						}
+						if string(head) != "ref: refs/heads/other\n" {
+							return fmt.Errorf("unexpected HEAD %q", head)
+						}
+						_, err = os.Stat(filepath.Join(path, "other-file"))
+						return err
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitWorktree_Branch_Create(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	first := testutils.GetRepositoryHead(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "other-file")
+	path := filepath.Join(t.TempDir(), "feature")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_worktree" "test" {
+						directory = "%s"
+						path      = "%s"
+						branch    = "feature"
+						revision  = "HEAD~1"
+						create    = true
+					}
+				`, directory, path),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_worktree.test", "branch", "feature"),
+					resource.TestCheckResourceAttr("git_worktree.test", "revision", "HEAD~1"),
+					resource.TestCheckResourceAttr("git_worktree.test", "create", "true"),
+					resource.TestCheckResourceAttr("git_worktree.test", "sha1", first.Hash().String()),
+					func(_ *terraform.State) error {
+						branch, err := repository.Reference("refs/heads/feature", true)
+						if err != nil {
+							return err
+						}
+						if branch.Hash() != first.Hash() {
+							return fmt.Errorf("expected branch at %s, got %s", first.Hash(), branch.Hash())
+						}
+						if _, err = os.Stat(filepath.Join(path, "other-file")); err == nil {
+							return fmt.Errorf("expected 'other-file' to be missing")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitWorktree_Branch_CheckedOut(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	path := filepath.Join(t.TempDir(), "linked")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_worktree" "test" {
+						directory = "%s"
+						path      = "%s"
+						branch    = "master"
+					}
+				`, directory, path),
+				ExpectError: regexp.MustCompile(`Cannot create worktree`),
+			},
+		},
+	})
+}
+
+func TestResourceGitWorktree_Branch_Missing(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	path := filepath.Join(t.TempDir(), "linked")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_worktree" "test" {
+						directory = "%s"
+						path      = "%s"
+						branch    = "does-not-exist"
+					}
+				`, directory, path),
+				ExpectError: regexp.MustCompile(`Cannot create worktree`),
+			},
+		},
+	})
+}
+
+func TestResourceGitWorktree_Branch_Revision(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	path := filepath.Join(t.TempDir(), "linked")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_worktree" "test" {
+						directory = "%s"
+						path      = "%s"
+						branch    = "master"
+						revision  = "HEAD"
+					}
+				`, directory, path),
+				ExpectError: regexp.MustCompile(`Invalid worktree options`),
+			},
+		},
+	})
+}
+
+func TestResourceGitWorktree_Locked(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	path := filepath.Join(t.TempDir(), "linked")
+	locked := filepath.Join(directory, ".git", "worktrees", "linked", "locked")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_worktree" "test" {
+						directory   = "%s"
+						path        = "%s"
+						locked      = true
+						lock_reason = "on removable media"
+					}
+				`, directory, path),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_worktree.test", "locked", "true"),
+					resource.TestCheckResourceAttr("git_worktree.test", "lock_reason", "on removable media"),
+					func(_ *terraform.State) error {
+						content, err := os.ReadFile(locked)
+						if err != nil {
+							return err
+						}
+						if string(content) != "on removable media" {
+							return fmt.Errorf("unexpected lock reason %q", content)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_worktree" "test" {
+						directory = "%s"
+						path      = "%s"
+					}
+				`, directory, path),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_worktree.test", "locked", "false"),
+					func(_ *terraform.State) error {
+						if _, err := os.Stat(locked); err == nil {
+							return fmt.Errorf("expected worktree to be unlocked")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitWorktree_LocalChanges(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	path := filepath.Join(t.TempDir(), "linked")
+	otherPath := filepath.Join(t.TempDir(), "other")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_worktree" "test" {
+						directory = "%s"
+						path      = "%s"
+					}
+				`, directory, path),
+			},
+			{
+				PreConfig: func() {
+					testutils.WriteFileContent(t, filepath.Join(path, "some-file"), "changed")
+				},
+				Config: fmt.Sprintf(`
+					resource "git_worktree" "test" {
+						directory = "%s"
+						path      = "%s"
+					}
+				`, directory, otherPath),
+				ExpectError: regexp.MustCompile(`Cannot remove worktree`),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "git_worktree" "test" {
+						directory = "%s"
+						path      = "%s"
+						force     = true
+					}
+				`, directory, path),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_worktree.test", "force", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitWorktree_Removed(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	path := filepath.Join(t.TempDir(), "linked")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_worktree" "test" {
+						directory = "%s"
+						path      = "%s"
+					}
+				`, directory, path),
+			},
+			{
+				PreConfig: func() {
+					if err := os.RemoveAll(path); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: fmt.Sprintf(`
+					resource "git_worktree" "test" {
+						directory = "%s"
+						path      = "%s"
+					}
+				`, directory, path),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(_ *terraform.State) error {
+						_, err := os.Stat(filepath.Join(path, "some-file"))
+						return err
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGitWorktree_Replaced(t *testing.T) {
+	t.Parallel()
+	directory, repository := testutils.CreateRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	path := filepath.Join(t.TempDir(), "linked")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_worktree" "test" {
+						directory = "%s"
+						path      = "%s"
+						force     = true
+					}
+				`, directory, path),
+			},
+			{
+				PreConfig: func() {
+					if err := os.RemoveAll(path); err != nil {
+						t.Fatal(err)
+					}
+					if _, err := git.PlainInit(path, false); err != nil {
+						t.Fatal(err)
+					}
+					if err := os.WriteFile(filepath.Join(path, "keep"), []byte("keep"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: fmt.Sprintf(`
+					resource "git_worktree" "test" {
+						directory = "%s"
+						path      = "%s"
+						force     = true
+					}
+				`, directory, path),
+				PlanOnly: true,
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if _, err := os.Stat(filepath.Join(path, "keep")); err != nil {
+				return fmt.Errorf("expected unrelated directory [%s] to be kept", path)
+			}
+			if _, err := os.Stat(filepath.Join(directory, ".git", "worktrees", "linked")); err == nil {
+				return fmt.Errorf("expected administrative files of worktree to be removed")
+			}
+			return nil
+		},
+	})
+}
+
+func TestResourceGitWorktree_Path_Missing(t *testing.T) {
+	t.Parallel()
+	directory, _ := testutils.CreateRepository(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_worktree" "test" {
+						directory = "%s"
+					}
+				`, directory),
+				ExpectError: regexp.MustCompile(`Missing required argument`),
+			},
+		},
+	})
+}
+
+func TestResourceGitWorktree_BareRepository(t *testing.T) {
+	t.Parallel()
+	_, repository := testutils.CreateRepository(t)
+	directory := testutils.CreateBareRepository(t)
+	worktree := testutils.GetRepositoryWorktree(t, repository)
+	testutils.AddAndCommitNewFile(t, worktree, "some-file")
+	testutils.CreateRemoteWithUrls(t, repository, "origin", []string{filepath.FromSlash(directory)})
+	testutils.GitPush(t, repository, "refs/heads/master:refs/heads/master")
+	head := testutils.GetRepositoryHead(t, repository)
+	path := filepath.Join(t.TempDir(), "linked")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "git_worktree" "test" {
+						directory = "%s"
+						path      = "%s"
+						branch    = "master"
+					}
+				`, directory, path),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("git_worktree.test", "sha1", head.Hash().String()),
+					func(_ *terraform.State) error {
+						_, err := os.Stat(filepath.Join(path, "some-file"))
+						return err
+					},
+				),
+			},
+		},
+	})
+}
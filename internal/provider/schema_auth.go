@@ -0,0 +1,454 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/metio/terraform-provider-git/internal/modifiers"
+)
+
+func authResourceAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description:         "The authentication credentials, if required, to use with the remote repository.",
+		MarkdownDescription: "The authentication credentials, if required, to use with the remote repository.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"basic": schema.SingleNestedAttribute{
+				Description:         "Configure basic auth authentication.",
+				MarkdownDescription: "Configure basic auth authentication.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Description:         "The basic auth username.",
+						MarkdownDescription: "The basic auth username.",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"password": schema.StringAttribute{
+						Description:         "The basic auth password.",
+						MarkdownDescription: "The basic auth password.",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("bearer")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_key")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_password")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_agent")),
+					objectvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("basic"),
+						path.MatchRelative().AtParent().AtName("bearer"),
+						path.MatchRelative().AtParent().AtName("ssh_key"),
+						path.MatchRelative().AtParent().AtName("ssh_password"),
+						path.MatchRelative().AtParent().AtName("ssh_agent"),
+					),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"bearer": schema.StringAttribute{
+				Description:         "Configure HTTP bearer token authentication. Note that services like GitHub use basic auth with your OAuth2 personal access token as the password.",
+				MarkdownDescription: "Configure HTTP bearer token authentication. **Note**: Services like GitHub use basic auth with your OAuth2 personal access token as the password.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("basic")),
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_key")),
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_password")),
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_agent")),
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("basic"),
+						path.MatchRelative().AtParent().AtName("bearer"),
+						path.MatchRelative().AtParent().AtName("ssh_key"),
+						path.MatchRelative().AtParent().AtName("ssh_password"),
+						path.MatchRelative().AtParent().AtName("ssh_agent"),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ssh_key": schema.SingleNestedAttribute{
+				Description:         "Configure SSH public/private key authentication.",
+				MarkdownDescription: "Configure SSH public/private key authentication.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Description:         "The SSH auth username.",
+						MarkdownDescription: "The SSH auth username.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							modifiers.DefaultString("git"),
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"password": schema.StringAttribute{
+						Description:         "The SSH key password.",
+						MarkdownDescription: "The SSH key password.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							modifiers.DefaultString(""),
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"private_key_path": schema.StringAttribute{
+						Description:         "The absolute path to the private SSH key.",
+						MarkdownDescription: "The absolute path to the private SSH key.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("private_key_pem")),
+							stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("private_key_pem")),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"private_key_pem": schema.StringAttribute{
+						Description:         "The private SSH key in PEM format.",
+						MarkdownDescription: "The private SSH key in PEM format.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("private_key_path")),
+							stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("private_key_path")),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"known_hosts": schema.SetAttribute{
+						Description:         "The list of known hosts files to accept. If none are specified, system defaults will be used.",
+						MarkdownDescription: "The list of known hosts files to accept. If none are specified, system defaults will be used.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						PlanModifiers: []planmodifier.Set{
+							setplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("basic")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("bearer")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_password")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_agent")),
+					objectvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("basic"),
+						path.MatchRelative().AtParent().AtName("bearer"),
+						path.MatchRelative().AtParent().AtName("ssh_key"),
+						path.MatchRelative().AtParent().AtName("ssh_password"),
+						path.MatchRelative().AtParent().AtName("ssh_agent"),
+					),
+				},
+			},
+			"ssh_password": schema.SingleNestedAttribute{
+				Description:         "Configure password based SSH authentication.",
+				MarkdownDescription: "Configure password based SSH authentication.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Description:         "The SSH username.",
+						MarkdownDescription: "The SSH username.",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"password": schema.StringAttribute{
+						Description:         "The SSH password.",
+						MarkdownDescription: "The SSH password.",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"known_hosts": schema.SetAttribute{
+						Description:         "The list of known hosts files to accept. If none are specified, system defaults will be used.",
+						MarkdownDescription: "The list of known hosts files to accept. If none are specified, system defaults will be used.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						PlanModifiers: []planmodifier.Set{
+							setplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("basic")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("bearer")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_key")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_agent")),
+					objectvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("basic"),
+						path.MatchRelative().AtParent().AtName("bearer"),
+						path.MatchRelative().AtParent().AtName("ssh_key"),
+						path.MatchRelative().AtParent().AtName("ssh_password"),
+						path.MatchRelative().AtParent().AtName("ssh_agent"),
+					),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"ssh_agent": schema.SingleNestedAttribute{
+				Description:         "Configure SSH agent based authentication.",
+				MarkdownDescription: "Configure SSH agent based authentication.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Description:         "The system username of the user talking to the SSH agent. Use an empty string in order to automatically fetch this.",
+						MarkdownDescription: "The system username of the user talking to the SSH agent. Use an empty string in order to automatically fetch this.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							modifiers.DefaultString(""),
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"known_hosts": schema.SetAttribute{
+						Description:         "The list of known hosts files to accept. If none are specified, system defaults will be used.",
+						MarkdownDescription: "The list of known hosts files to accept. If none are specified, system defaults will be used.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						PlanModifiers: []planmodifier.Set{
+							setplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("basic")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("bearer")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_key")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_password")),
+					objectvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("basic"),
+						path.MatchRelative().AtParent().AtName("bearer"),
+						path.MatchRelative().AtParent().AtName("ssh_key"),
+						path.MatchRelative().AtParent().AtName("ssh_password"),
+						path.MatchRelative().AtParent().AtName("ssh_agent"),
+					),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// authDataSourceAttribute returns the same attributes as authResourceAttribute without any plan modifiers, since data
+// sources are read on every plan. Unset attributes fall back to their defaults when the credentials are created.
+func authDataSourceAttribute() datasourceschema.SingleNestedAttribute {
+	return dataSourceAttribute(authResourceAttribute()).(datasourceschema.SingleNestedAttribute)
+}
+
+// dataSourceAttribute converts the given resource attribute and all of its nested attributes into data source
+// attributes. Computed attributes become optional, since their defaults are set by plan modifiers.
+func dataSourceAttribute(attribute schema.Attribute) datasourceschema.Attribute {
+	switch a := attribute.(type) {
+	case schema.SingleNestedAttribute:
+		attributes := make(map[string]datasourceschema.Attribute, len(a.Attributes))
+		for name, nested := range a.Attributes {
+			attributes[name] = dataSourceAttribute(nested)
+		}
+		return datasourceschema.SingleNestedAttribute{
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Sensitive:           a.Sensitive,
+			Attributes:          attributes,
+			Validators:          a.Validators,
+		}
+	case schema.StringAttribute:
+		return datasourceschema.StringAttribute{
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Sensitive:           a.Sensitive,
+			Validators:          a.Validators,
+		}
+	case schema.SetAttribute:
+		return datasourceschema.SetAttribute{
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			ElementType:         a.ElementType,
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Sensitive:           a.Sensitive,
+			Validators:          a.Validators,
+		}
+	default:
+		panic(fmt.Sprintf("unsupported attribute type %T", attribute))
+	}
+}
+
+func authProviderAttribute() providerschema.SingleNestedAttribute {
+	return providerschema.SingleNestedAttribute{
+		Description:         "The default authentication credentials to use with remote repositories. Resources and data sources that specify their own 'auth' attribute ignore this value.",
+		MarkdownDescription: "The default authentication credentials to use with remote repositories. Resources and data sources that specify their own `auth` attribute ignore this value.",
+		Optional:            true,
+		Attributes: map[string]providerschema.Attribute{
+			"basic": providerschema.SingleNestedAttribute{
+				Description:         "Configure basic auth authentication.",
+				MarkdownDescription: "Configure basic auth authentication.",
+				Optional:            true,
+				Attributes: map[string]providerschema.Attribute{
+					"username": providerschema.StringAttribute{
+						Description:         "The basic auth username.",
+						MarkdownDescription: "The basic auth username.",
+						Required:            true,
+					},
+					"password": providerschema.StringAttribute{
+						Description:         "The basic auth password.",
+						MarkdownDescription: "The basic auth password.",
+						Required:            true,
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("bearer")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_key")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_password")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_agent")),
+				},
+			},
+			"bearer": providerschema.StringAttribute{
+				Description:         "Configure HTTP bearer token authentication. Note that services like GitHub use basic auth with your OAuth2 personal access token as the password.",
+				MarkdownDescription: "Configure HTTP bearer token authentication. **Note**: Services like GitHub use basic auth with your OAuth2 personal access token as the password.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("basic")),
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_key")),
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_password")),
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_agent")),
+				},
+			},
+			"ssh_key": providerschema.SingleNestedAttribute{
+				Description:         "Configure SSH public/private key authentication.",
+				MarkdownDescription: "Configure SSH public/private key authentication.",
+				Optional:            true,
+				Attributes: map[string]providerschema.Attribute{
+					"username": providerschema.StringAttribute{
+						Description:         "The SSH auth username. Defaults to 'git'.",
+						MarkdownDescription: "The SSH auth username. Defaults to `git`.",
+						Optional:            true,
+					},
+					"password": providerschema.StringAttribute{
+						Description:         "The SSH key password.",
+						MarkdownDescription: "The SSH key password.",
+						Optional:            true,
+					},
+					"private_key_path": providerschema.StringAttribute{
+						Description:         "The absolute path to the private SSH key.",
+						MarkdownDescription: "The absolute path to the private SSH key.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("private_key_pem")),
+							stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("private_key_pem")),
+						},
+					},
+					"private_key_pem": providerschema.StringAttribute{
+						Description:         "The private SSH key in PEM format.",
+						MarkdownDescription: "The private SSH key in PEM format.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("private_key_path")),
+							stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("private_key_path")),
+						},
+					},
+					"known_hosts": providerschema.SetAttribute{
+						Description:         "The list of known hosts files to accept. If none are specified, system defaults will be used.",
+						MarkdownDescription: "The list of known hosts files to accept. If none are specified, system defaults will be used.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("basic")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("bearer")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_password")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_agent")),
+				},
+			},
+			"ssh_password": providerschema.SingleNestedAttribute{
+				Description:         "Configure password based SSH authentication.",
+				MarkdownDescription: "Configure password based SSH authentication.",
+				Optional:            true,
+				Attributes: map[string]providerschema.Attribute{
+					"username": providerschema.StringAttribute{
+						Description:         "The SSH username.",
+						MarkdownDescription: "The SSH username.",
+						Required:            true,
+					},
+					"password": providerschema.StringAttribute{
+						Description:         "The SSH password.",
+						MarkdownDescription: "The SSH password.",
+						Required:            true,
+					},
+					"known_hosts": providerschema.SetAttribute{
+						Description:         "The list of known hosts files to accept. If none are specified, system defaults will be used.",
+						MarkdownDescription: "The list of known hosts files to accept. If none are specified, system defaults will be used.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("basic")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("bearer")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_key")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_agent")),
+				},
+			},
+			"ssh_agent": providerschema.SingleNestedAttribute{
+				Description:         "Configure SSH agent based authentication.",
+				MarkdownDescription: "Configure SSH agent based authentication.",
+				Optional:            true,
+				Attributes: map[string]providerschema.Attribute{
+					"username": providerschema.StringAttribute{
+						Description:         "The system username of the user talking to the SSH agent. Leave empty in order to automatically fetch this.",
+						MarkdownDescription: "The system username of the user talking to the SSH agent. Leave empty in order to automatically fetch this.",
+						Optional:            true,
+					},
+					"known_hosts": providerschema.SetAttribute{
+						Description:         "The list of known hosts files to accept. If none are specified, system defaults will be used.",
+						MarkdownDescription: "The list of known hosts files to accept. If none are specified, system defaults will be used.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("basic")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("bearer")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_key")),
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssh_password")),
+				},
+			},
+		},
+	}
+}
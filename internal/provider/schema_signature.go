@@ -0,0 +1,62 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func authorResourceAttribute() schema.SingleNestedAttribute {
+	return signatureResourceAttribute(
+		"author",
+		"The original author of the commit. If none is specified, the author will be read from the Git configuration.",
+	)
+}
+
+func committerResourceAttribute() schema.SingleNestedAttribute {
+	return signatureResourceAttribute(
+		"committer",
+		"The person performing the commit. If none is specified, the author is used as committer.",
+	)
+}
+
+func signatureResourceAttribute(role string, description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description:         description,
+		MarkdownDescription: description,
+		Computed:            true,
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description:         "The name of the " + role + ".",
+				MarkdownDescription: "The name of the " + role + ".",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description:         "The email address of the " + role + ".",
+				MarkdownDescription: "The email address of the " + role + ".",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+			objectplanmodifier.RequiresReplace(),
+		},
+	}
+}
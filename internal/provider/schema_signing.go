@@ -0,0 +1,72 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func signingResourceAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description:         "The private key used to sign the created object. If none is specified, the object will not be signed.",
+		MarkdownDescription: "The private key used to sign the created object. If none is specified, the object will not be signed.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"format": schema.StringAttribute{
+				Description:         "The format of the signature similar to 'gpg.format'. Possible values are 'openpgp' and 'ssh'. Defaults to 'openpgp'.",
+				MarkdownDescription: "The format of the signature similar to `gpg.format`. Possible values are `openpgp` and `ssh`. Defaults to `openpgp`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("openpgp", "ssh"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"private_key_pem": schema.StringAttribute{
+				Description:         "The armored OpenPGP private key or the OpenSSH private key in PEM format.",
+				MarkdownDescription: "The armored OpenPGP private key or the OpenSSH private key in PEM format.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("private_key_path")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"private_key_path": schema.StringAttribute{
+				Description:         "The absolute path to the armored OpenPGP private key or the OpenSSH private key.",
+				MarkdownDescription: "The absolute path to the armored OpenPGP private key or the OpenSSH private key.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("private_key_pem")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"passphrase": schema.StringAttribute{
+				Description:         "The passphrase to decrypt the private key with, if it is encrypted.",
+				MarkdownDescription: "The passphrase to decrypt the private key with, if it is encrypted.",
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+	}
+}
@@ -9,7 +9,9 @@ package testutils
 
 import (
 	"fmt"
+	"os"
 
+	"github.com/go-git/go-git/v5"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -66,3 +68,18 @@ func CheckResourceAttrInstanceState(attributeName, attributeValue string) resour
 		return nil
 	}
 }
+
+func CheckFileContent(worktree *git.Worktree, name string, expectedContent string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		content, err := os.ReadFile(FileInWorktree(worktree, name))
+		if err != nil {
+			return err
+		}
+
+		if string(content) != expectedContent {
+			return fmt.Errorf("file '%s' expected: '%s', got: '%s'", name, expectedContent, string(content))
+		}
+
+		return nil
+	}
+}
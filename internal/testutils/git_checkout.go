@@ -22,3 +22,13 @@ func TestGitCheckout(t *testing.T, worktree *git.Worktree, hash plumbing.Hash) {
 		t.Fatal(err)
 	}
 }
+
+func GitCheckoutBranch(t *testing.T, worktree *git.Worktree, branch string, create bool) {
+	err := worktree.Checkout(&git.CheckoutOptions{
+		Branch: plumbing.NewBranchReferenceName(branch),
+		Create: create,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
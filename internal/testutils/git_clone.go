@@ -0,0 +1,26 @@
+//go:build testing
+
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package testutils
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+)
+
+func GitClone(t *testing.T, url string) (string, *git.Repository) {
+	directory := TemporaryDirectory(t)
+	repository, err := git.PlainClone(directory, false, &git.CloneOptions{
+		URL: filepath.FromSlash(url),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return directory, repository
+}
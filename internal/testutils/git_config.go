@@ -8,6 +8,8 @@
 package testutils
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/go-git/go-git/v5"
@@ -40,3 +42,15 @@ func WriteConfig(t *testing.T, repository *git.Repository, cfg *config.Config) {
 		t.Fatal(err)
 	}
 }
+
+func AppendConfig(t *testing.T, directory string, content string) {
+	file, err := os.OpenFile(filepath.Join(directory, ".git", "config"), os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	_, err = file.WriteString(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,28 @@
+//go:build testing
+
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package testutils
+
+import (
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+)
+
+func GitPush(t *testing.T, repository *git.Repository, refSpecs ...string) {
+	specs := make([]config.RefSpec, len(refSpecs))
+	for index, refSpec := range refSpecs {
+		specs[index] = config.RefSpec(refSpec)
+	}
+	err := repository.Push(&git.PushOptions{
+		RefSpecs: specs,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,58 @@
+//go:build testing
+
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package testutils
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+)
+
+// AddSubmodule declares a submodule in '.gitmodules' and stages it at the given commit without cloning it.
+func AddSubmodule(t *testing.T, repository *git.Repository, name string, path string, url string, hash plumbing.Hash) {
+	worktree := GetRepositoryWorktree(t, repository)
+	gitmodules := FileInWorktree(worktree, ".gitmodules")
+
+	file, err := os.OpenFile(gitmodules, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = fmt.Fprintf(file, "[submodule \"%s\"]\n\tpath = %s\n\turl = %s\n", name, path, url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = file.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	GitAdd(t, worktree, ".gitmodules")
+
+	SetSubmoduleHash(t, repository, path, hash)
+}
+
+// SetSubmoduleHash stages the given commit for the submodule at the given path.
+func SetSubmoduleHash(t *testing.T, repository *git.Repository, path string, hash plumbing.Hash) {
+	index, err := repository.Storer.Index()
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry, err := index.Entry(path)
+	if err != nil {
+		entry = index.Add(path)
+	}
+	entry.Hash = hash
+	entry.Mode = filemode.Submodule
+	err = repository.Storer.SetIndex(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
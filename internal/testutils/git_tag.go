@@ -23,3 +23,11 @@ func CreateTag(t *testing.T, repository *git.Repository, tag string) {
 		t.Fatal(err)
 	}
 }
+
+func CreateTagWith(t *testing.T, repository *git.Repository, tag string, options *git.CreateTagOptions) {
+	head := GetRepositoryHead(t, repository)
+	_, err := repository.CreateTag(tag, head.Hash(), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -8,6 +8,7 @@
 package testutils
 
 import (
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -57,3 +58,14 @@ func AddAndCommitNewFile(t *testing.T, worktree *git.Worktree, name string) {
 	GitAdd(t, worktree, name)
 	GitCommit(t, worktree)
 }
+
+func AddAndCommitNewFiles(t *testing.T, worktree *git.Worktree, names ...string) {
+	for _, name := range names {
+		if err := os.MkdirAll(filepath.Dir(FileInWorktree(worktree, name)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		WriteFileInWorktree(t, worktree, name)
+		GitAdd(t, worktree, name)
+	}
+	GitCommit(t, worktree)
+}
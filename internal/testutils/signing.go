@@ -0,0 +1,100 @@
+//go:build testing
+
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-git Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package testutils
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"golang.org/x/crypto/ssh"
+)
+
+// OpenPGPKey generates a new OpenPGP key and returns the entity along with its armored private and public keys. The
+// armored private key is encrypted with the given passphrase unless it is empty.
+func OpenPGPKey(t *testing.T, passphrase string) (*openpgp.Entity, string, string) {
+	entity, err := openpgp.NewEntity("Some Person", "", "person@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var publicKey bytes.Buffer
+	writer, err := armor.Encode(&publicKey, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = entity.Serialize(writer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = writer.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if passphrase != "" {
+		err = entity.EncryptPrivateKeys([]byte(passphrase), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var privateKey bytes.Buffer
+	writer, err = armor.Encode(&privateKey, openpgp.PrivateKeyType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = entity.SerializePrivateWithoutSigning(writer, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = writer.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return entity, privateKey.String(), publicKey.String()
+}
+
+// SSHKey generates a new ed25519 key and returns its private key in PEM format and its public key in the
+// 'authorized_keys' format.
+func SSHKey(t *testing.T) (string, string) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block, err := ssh.MarshalPrivateKey(privateKey, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(block)), string(ssh.MarshalAuthorizedKey(sshPublicKey))
+}
+
+// AllowedSignersFile writes an SSH allowed signers file which allows the given principals to sign with the given
+// public key and returns its path.
+func AllowedSignersFile(t *testing.T, principals string, publicKey string) string {
+	path := filepath.Join(t.TempDir(), "allowed_signers")
+	err := os.WriteFile(path, []byte(principals+" "+publicKey), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+}